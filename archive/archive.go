@@ -0,0 +1,312 @@
+// Package archive exports Merit Aktiva documents to a Merit independent
+// archive on disk: one directory per fiscal year containing every document as
+// JSON, its attachments, an index and a SHA256SUMS file.
+//
+// When the Exporter has a Key the documents and attachments are encrypted with
+// AES-256-GCM before they're written. The index and SHA256SUMS stay readable,
+// the checksums are of the encrypted files so the archive can still be
+// checked without the key.
+package archive
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	IndexFile     = "index.json"
+	ChecksumsFile = "SHA256SUMS"
+)
+
+// Kinds of index entries
+const (
+	KindDocument   = "document"
+	KindAttachment = "attachment"
+)
+
+// Document is a single document to archive
+type Document struct {
+	// Document type, used as subdirectory: "glbatch", "invoice", ...
+	Type string
+	ID   string
+	Date time.Time
+	// Data is written as indented JSON
+	Data        interface{}
+	Attachments []Attachment
+}
+
+// Attachment is a file belonging to a document, e.g. the invoice PDF
+type Attachment struct {
+	Name string
+	Data []byte
+}
+
+// Source lists the documents of one type dated in [start, end]
+type Source interface {
+	Documents(ctx context.Context, start, end time.Time) ([]Document, error)
+}
+
+// IndexEntry describes one file in the archive
+type IndexEntry struct {
+	Type string    `json:"type"`
+	ID   string    `json:"id"`
+	Date time.Time `json:"date"`
+	// KindDocument for the JSON of the document, KindAttachment for its files
+	Kind string `json:"kind,omitempty"`
+	// Path relative to the fiscal year directory
+	Path   string `json:"path"`
+	SHA256 string `json:"sha256"`
+	Size   int    `json:"size"`
+	// The file is encrypted with the archive key, see Encrypt
+	Encrypted bool `json:"encrypted,omitempty"`
+}
+
+// Index lists all files of one fiscal year
+type Index struct {
+	FiscalYear int          `json:"fiscal_year"`
+	Exported   time.Time    `json:"exported"`
+	Entries    []IndexEntry `json:"entries"`
+}
+
+// Exporter writes the documents of all sources to Dir
+type Exporter struct {
+	Dir     string
+	Sources []Source
+	// First month of the fiscal year, January when zero
+	FiscalYearStart time.Month
+	// Optional KeySize bytes key, when set all documents and attachments are
+	// encrypted
+	Key []byte
+}
+
+func NewExporter(dir string, sources ...Source) *Exporter {
+	return &Exporter{
+		Dir:             dir,
+		Sources:         sources,
+		FiscalYearStart: time.January,
+	}
+}
+
+// FiscalYear returns the fiscal year t falls in. Fiscal years not starting in
+// January are named after the calendar year they end in.
+func (e *Exporter) FiscalYear(t time.Time) int {
+	if e.FiscalYearStart <= time.January || t.Month() < e.FiscalYearStart {
+		return t.Year()
+	}
+	return t.Year() + 1
+}
+
+// Export archives all documents dated in [start, end] and returns the indexes
+// of the fiscal years that were written. The entries of earlier exports of
+// those years are kept, so a year can be exported in parts or resumed.
+func (e *Exporter) Export(ctx context.Context, start, end time.Time) ([]Index, error) {
+	indexes := map[int]*Index{}
+
+	for _, source := range e.Sources {
+		docs, err := source.Documents(ctx, start, end)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, doc := range docs {
+			year := e.FiscalYear(doc.Date)
+			index, ok := indexes[year]
+			if !ok {
+				index = &Index{FiscalYear: year, Exported: time.Now(), Entries: []IndexEntry{}}
+				indexes[year] = index
+			}
+
+			entries, err := e.writeDocument(year, doc)
+			if err != nil {
+				return nil, err
+			}
+			index.Entries = append(index.Entries, entries...)
+		}
+	}
+
+	years := []int{}
+	for year := range indexes {
+		years = append(years, year)
+	}
+	sort.Ints(years)
+
+	result := []Index{}
+	for _, year := range years {
+		index, err := e.mergeIndex(*indexes[year])
+		if err != nil {
+			return nil, err
+		}
+
+		err = e.writeIndex(index)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, index)
+	}
+
+	return result, nil
+}
+
+// YearDir returns the directory of a fiscal year
+func (e *Exporter) YearDir(year int) string {
+	return filepath.Join(e.Dir, fmt.Sprint(year))
+}
+
+func (e *Exporter) writeDocument(year int, doc Document) ([]IndexEntry, error) {
+	b, err := json.MarshalIndent(doc.Data, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	base := filepath.Join(safeName(doc.Type), safeName(doc.ID))
+	entries := []IndexEntry{}
+
+	entry, err := e.writeFile(year, base+".json", b)
+	if err != nil {
+		return nil, err
+	}
+	entry.Type, entry.ID, entry.Date, entry.Kind = doc.Type, doc.ID, doc.Date, KindDocument
+	entries = append(entries, entry)
+
+	for _, a := range doc.Attachments {
+		entry, err := e.writeFile(year, filepath.Join(base, safeName(a.Name)), a.Data)
+		if err != nil {
+			return nil, err
+		}
+		entry.Type, entry.ID, entry.Date, entry.Kind = doc.Type, doc.ID, doc.Date, KindAttachment
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (e *Exporter) writeFile(year int, path string, data []byte) (IndexEntry, error) {
+	full, err := within(e.YearDir(year), filepath.ToSlash(path))
+	if err != nil {
+		return IndexEntry{}, err
+	}
+
+	err = os.MkdirAll(filepath.Dir(full), 0755)
+	if err != nil {
+		return IndexEntry{}, err
+	}
+
+	encrypted := e.Key != nil
+	if encrypted {
+		data, err = Encrypt(e.Key, filepath.ToSlash(path), data)
+		if err != nil {
+			return IndexEntry{}, err
+		}
+	}
+
+	err = ioutil.WriteFile(full, data, 0644)
+	if err != nil {
+		return IndexEntry{}, err
+	}
+
+	return IndexEntry{
+		Path:      filepath.ToSlash(path),
+		SHA256:    Checksum(data),
+		Size:      len(data),
+		Encrypted: encrypted,
+	}, nil
+}
+
+// mergeIndex adds the entries of the existing index of the fiscal year to
+// index. Files that were written again replace their old entry.
+func (e *Exporter) mergeIndex(index Index) (Index, error) {
+	b, err := ioutil.ReadFile(filepath.Join(e.YearDir(index.FiscalYear), IndexFile))
+	if os.IsNotExist(err) {
+		return index, nil
+	}
+	if err != nil {
+		return index, err
+	}
+
+	existing := Index{}
+	err = json.Unmarshal(b, &existing)
+	if err != nil {
+		return index, fmt.Errorf("reading index of %d: %w", index.FiscalYear, err)
+	}
+
+	positions := map[string]int{}
+	entries := []IndexEntry{}
+	for _, entry := range append(existing.Entries, index.Entries...) {
+		if i, ok := positions[entry.Path]; ok {
+			entries[i] = entry
+			continue
+		}
+		positions[entry.Path] = len(entries)
+		entries = append(entries, entry)
+	}
+
+	index.Entries = entries
+	return index, nil
+}
+
+func (e *Exporter) writeIndex(index Index) error {
+	b, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := e.YearDir(index.FiscalYear)
+	err = ioutil.WriteFile(filepath.Join(dir, IndexFile), b, 0644)
+	if err != nil {
+		return err
+	}
+
+	// same format as sha256sum so the archive can be checked without this
+	// package
+	sums := []string{}
+	for _, entry := range index.Entries {
+		sums = append(sums, fmt.Sprintf("%s  %s", entry.SHA256, entry.Path))
+	}
+	sums = append(sums, "")
+
+	return ioutil.WriteFile(filepath.Join(dir, ChecksumsFile), []byte(strings.Join(sums, "\n")), 0644)
+}
+
+// Checksum returns the hex encoded SHA-256 of data
+func Checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// safeName makes a document type, number or attachment name usable as a
+// single path element. Separators are replaced and empty names and names of
+// only dots, like "..", become underscores so they can't point to the
+// directory or its parent.
+func safeName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == ':' || r == 0 {
+			return '_'
+		}
+		return r
+	}, name)
+
+	if strings.Trim(name, ".") == "" {
+		return strings.Repeat("_", len(name)+1)
+	}
+	return name
+}
+
+// within joins root and the slash separated path and checks that the result
+// stays inside root
+func within(root, path string) (string, error) {
+	full := filepath.Join(root, filepath.FromSlash(path))
+	rel, err := filepath.Rel(root, full)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) || filepath.IsAbs(path) {
+		return "", fmt.Errorf("path \"%s\" is outside of the archive", path)
+	}
+	return full, nil
+}
@@ -0,0 +1,176 @@
+package archive_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/omniboost/go-merit-aktiva/archive"
+)
+
+type staticSource []archive.Document
+
+func (s staticSource) Documents(ctx context.Context, start, end time.Time) ([]archive.Document, error) {
+	return s, nil
+}
+
+func TestExport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	source := staticSource{
+		{
+			Type: "invoice",
+			ID:   "1",
+			Date: time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC),
+			Data: map[string]string{"InvoiceNo": "1"},
+			Attachments: []archive.Attachment{
+				{Name: "1.pdf", Data: []byte("%PDF")},
+			},
+		},
+		{
+			Type: "invoice",
+			ID:   "2",
+			Date: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			Data: map[string]string{"InvoiceNo": "2"},
+		},
+	}
+
+	exporter := archive.NewExporter(dir, source)
+	indexes, err := exporter.Export(context.Background(), time.Time{}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(indexes) != 2 || indexes[0].FiscalYear != 2019 || indexes[1].FiscalYear != 2020 {
+		t.Fatalf("unexpected indexes: %+v", indexes)
+	}
+
+	if len(indexes[0].Entries) != 2 {
+		t.Errorf("expected document and attachment in 2019, got %+v", indexes[0].Entries)
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "2019", archive.IndexFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	index := archive.Index{}
+	err = json.Unmarshal(b, &index)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pdf, err := ioutil.ReadFile(filepath.Join(dir, "2019", "invoice", "1", "1.pdf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if index.Entries[1].SHA256 != archive.Checksum(pdf) {
+		t.Errorf("checksum mismatch for attachment")
+	}
+}
+
+func TestExportUnsafeNames(t *testing.T) {
+	dir, err := ioutil.TempDir("", "archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	root := filepath.Join(dir, "root")
+	source := staticSource{
+		{
+			Type: "..",
+			ID:   "..",
+			Date: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			Data: map[string]string{"InvoiceNo": ".."},
+			Attachments: []archive.Attachment{
+				{Name: "../../escape.pdf", Data: []byte("%PDF")},
+				{Name: "...", Data: []byte("%PDF")},
+			},
+		},
+	}
+
+	indexes, err := archive.NewExporter(root, source).Export(context.Background(), time.Time{}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, entry := range indexes[0].Entries {
+		full := filepath.Join(root, "2020", filepath.FromSlash(entry.Path))
+		rel, err := filepath.Rel(filepath.Join(root, "2020"), full)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			t.Errorf("entry %s is outside of the archive", entry.Path)
+		}
+	}
+
+	files, _ := ioutil.ReadDir(dir)
+	if len(files) != 1 {
+		t.Errorf("expected only the archive directory in %s, got %d entries", dir, len(files))
+	}
+}
+
+func TestExportMergesIndex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	january := staticSource{
+		{Type: "invoice", ID: "1", Date: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), Data: map[string]string{"InvoiceNo": "1"}},
+		{Type: "invoice", ID: "2", Date: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), Data: map[string]string{"InvoiceNo": "2"}},
+	}
+	february := staticSource{
+		{Type: "invoice", ID: "2", Date: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), Data: map[string]string{"InvoiceNo": "2b"}},
+		{Type: "invoice", ID: "3", Date: time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC), Data: map[string]string{"InvoiceNo": "3"}},
+	}
+
+	for _, source := range []staticSource{january, february} {
+		_, err = archive.NewExporter(dir, source).Export(context.Background(), time.Time{}, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "2020", archive.IndexFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	index := archive.Index{}
+	err = json.Unmarshal(b, &index)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paths := []string{}
+	for _, entry := range index.Entries {
+		paths = append(paths, entry.Path)
+	}
+	if strings.Join(paths, ",") != "invoice/1.json,invoice/2.json,invoice/3.json" {
+		t.Errorf("unexpected entries %v", paths)
+	}
+
+	sums, err := ioutil.ReadFile(filepath.Join(dir, "2020", archive.ChecksumsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(string(sums), "\n") != 3 {
+		t.Errorf("expected 3 checksums, got %q", sums)
+	}
+
+	report, err := archive.NewVerifier(dir).Verify(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.OK() || report.Files != 3 {
+		t.Errorf("unexpected report: %+v", report)
+	}
+}
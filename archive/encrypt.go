@@ -0,0 +1,76 @@
+package archive
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// KeySize is the length of an archive key, files are encrypted with
+// AES-256-GCM
+const KeySize = 32
+
+// ParseKey decodes a hex encoded archive key
+func ParseKey(s string) ([]byte, error) {
+	key, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("archive key is not hex encoded: %s", err)
+	}
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("archive key must be %d bytes, got %d", KeySize, len(key))
+	}
+	return key, nil
+}
+
+// Encrypt encrypts the file at the slash separated path relative to the fiscal
+// year directory. The result is the random nonce followed by the ciphertext.
+// The path is authenticated as well, so a file can't be swapped for another
+// one of the same archive.
+func Encrypt(key []byte, path string, data []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(data)+aead.Overhead())
+	_, err = io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, data, []byte(path)), nil
+}
+
+// Decrypt reverses Encrypt
+func Decrypt(key []byte, path string, data []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < aead.NonceSize() {
+		return nil, errors.New("encrypted file is too short")
+	}
+
+	plain, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], []byte(path))
+	if err != nil {
+		return nil, errors.New("decrypting file failed, wrong key or tampered file")
+	}
+	return plain, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("archive key must be %d bytes, got %d", KeySize, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,69 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+// GLBatchSource archives the GL batches of a company including their lines
+type GLBatchSource struct {
+	Client *aktiva.Client
+}
+
+func (s GLBatchSource) Documents(ctx context.Context, start, end time.Time) ([]Document, error) {
+	// Merit limits the period of a single request
+	batches := aktiva.GetGLBatchesResponseBody{}
+	for _, w := range aktiva.SplitDateRange(start, end, aktiva.MaxPeriodMonths) {
+		req := s.Client.NewGetGLBatchesRequest()
+		req.RequestBody().PeriodStart = aktiva.Date{Time: w.Start}
+		req.RequestBody().PeriodEnd = aktiva.Date{Time: w.End}
+		resp, err := req.Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		batches = append(batches, resp...)
+	}
+
+	docs := []Document{}
+	for _, b := range batches {
+		batch, err := s.Fetch(ctx, "glbatch", b.GLBID)
+		if err != nil {
+			return nil, err
+		}
+
+		date, err := parseBatchDate(b.BatchDate)
+		if err != nil {
+			return nil, err
+		}
+
+		docs = append(docs, Document{
+			Type: "glbatch",
+			ID:   b.GLBID,
+			Date: date,
+			Data: batch,
+		})
+	}
+
+	return docs, nil
+}
+
+// Fetch returns the GL batch with id, including its lines
+func (s GLBatchSource) Fetch(ctx context.Context, docType, id string) (interface{}, error) {
+	if docType != "glbatch" {
+		return nil, fmt.Errorf("unsupported document type \"%s\"", docType)
+	}
+
+	req := s.Client.NewGetGLBatchRequest()
+	req.RequestBody().ID = uuid.FromStringOrNil(id)
+	return req.Do(ctx)
+}
+
+func parseBatchDate(s string) (time.Time, error) {
+	d := aktiva.Date{}
+	err := d.UnmarshalJSON([]byte(`"` + s + `"`))
+	return d.Time, err
+}
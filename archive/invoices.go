@@ -0,0 +1,128 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+// InvoiceSource archives the sales invoices of a company including their rows,
+// payments and attached file
+type InvoiceSource struct {
+	Client *aktiva.Client
+}
+
+func (s InvoiceSource) Documents(ctx context.Context, start, end time.Time) ([]Document, error) {
+	invoices := aktiva.GetInvoicesResponseBody{}
+	for _, w := range aktiva.SplitDateRange(start, end, aktiva.MaxPeriodMonths) {
+		req := s.Client.NewGetInvoicesRequest()
+		req.RequestBody().PeriodStart = aktiva.Date{Time: w.Start}
+		req.RequestBody().PeriodEnd = aktiva.Date{Time: w.End}
+		resp, err := req.Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, resp...)
+	}
+
+	docs := []Document{}
+	for _, i := range invoices {
+		req := s.Client.NewGetInvoiceRequest()
+		req.RequestBody().ID = uuid.FromStringOrNil(i.SIHID)
+		req.RequestBody().AddAttachment = true
+		invoice, err := req.Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// the attachment is archived as a file of its own
+		doc := Document{
+			Type: "invoice",
+			ID:   i.SIHID,
+			Date: i.DocumentDate.Time,
+		}
+		if invoice.Attachment != nil && invoice.Attachment.FileName != "" {
+			doc.Attachments = []Attachment{{Name: invoice.Attachment.FileName, Data: invoice.Attachment.FileContent}}
+		}
+		invoice.Attachment = nil
+		doc.Data = invoice
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
+// Fetch returns the sales invoice with id, without its attachment like
+// Documents archives it
+func (s InvoiceSource) Fetch(ctx context.Context, docType, id string) (interface{}, error) {
+	if docType != "invoice" {
+		return nil, fmt.Errorf("unsupported document type \"%s\"", docType)
+	}
+
+	req := s.Client.NewGetInvoiceRequest()
+	req.RequestBody().ID = uuid.FromStringOrNil(id)
+	return req.Do(ctx)
+}
+
+// PurchaseInvoiceSource archives the purchase invoices of a company including
+// their rows, payments and attached file
+type PurchaseInvoiceSource struct {
+	Client *aktiva.Client
+}
+
+func (s PurchaseInvoiceSource) Documents(ctx context.Context, start, end time.Time) ([]Document, error) {
+	invoices := aktiva.GetPurchaseInvoicesResponseBody{}
+	for _, w := range aktiva.SplitDateRange(start, end, aktiva.MaxPeriodMonths) {
+		req := s.Client.NewGetPurchaseInvoicesRequest()
+		req.RequestBody().PeriodStart = aktiva.Date{Time: w.Start}
+		req.RequestBody().PeriodEnd = aktiva.Date{Time: w.End}
+		resp, err := req.Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, resp...)
+	}
+
+	docs := []Document{}
+	for _, i := range invoices {
+		req := s.Client.NewGetPurchaseInvoiceRequest()
+		req.RequestBody().ID = uuid.FromStringOrNil(i.PIHID)
+		invoice, err := req.Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// the attachment is archived as a file of its own
+		doc := Document{
+			Type: "purchinvoice",
+			ID:   i.PIHID,
+			Date: i.DocumentDate.Time,
+		}
+		if invoice.Attachment != nil && invoice.Attachment.FileName != "" {
+			doc.Attachments = []Attachment{{Name: invoice.Attachment.FileName, Data: invoice.Attachment.FileContent}}
+		}
+		invoice.Attachment = nil
+		doc.Data = invoice
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
+// Fetch returns the purchase invoice with id, without its attachment like
+// Documents archives it
+func (s PurchaseInvoiceSource) Fetch(ctx context.Context, docType, id string) (interface{}, error) {
+	if docType != "purchinvoice" {
+		return nil, fmt.Errorf("unsupported document type \"%s\"", docType)
+	}
+
+	req := s.Client.NewGetPurchaseInvoiceRequest()
+	req.RequestBody().ID = uuid.FromStringOrNil(id)
+	req.RequestBody().SkipAttachment = true
+	invoice, err := req.Do(ctx)
+	invoice.Attachment = nil
+	return invoice, err
+}
@@ -0,0 +1,47 @@
+package archive_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+	"github.com/omniboost/go-merit-aktiva/archive"
+)
+
+func TestInvoiceSource(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch path.Base(r.URL.Path) {
+		case "getinvoices":
+			w.Write([]byte(`[{"SIHId": "e0f3c1a2-0000-0000-0000-000000000000", "InvoiceNo": "INV-1", "DocumentDate": "20200301"}]`))
+		case "getinvoice":
+			w.Write([]byte(`{"Header": {"InvoiceNo": "INV-1"}, "Attachment": {"FileName": "INV-1.pdf", "FileContent": "JVBERg=="}}`))
+		}
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	start := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	docs, err := archive.InvoiceSource{Client: c}.Documents(context.Background(), start, start)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(docs) != 1 || docs[0].Type != "invoice" || !docs[0].Date.Equal(start) {
+		t.Fatalf("unexpected documents %+v", docs)
+	}
+	if len(docs[0].Attachments) != 1 || docs[0].Attachments[0].Name != "INV-1.pdf" || string(docs[0].Attachments[0].Data) != "%PDF" {
+		t.Errorf("unexpected attachments %+v", docs[0].Attachments)
+	}
+	if docs[0].Data.(aktiva.GetInvoiceResponseBody).Attachment != nil {
+		t.Error("expected the attachment to be left out of the document")
+	}
+}
@@ -0,0 +1,215 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Fetcher fetches a single document from the API again, so it can be compared
+// with the archived copy
+type Fetcher interface {
+	Fetch(ctx context.Context, docType, id string) (interface{}, error)
+}
+
+// Fetchers passes Fetch on to the fetcher of the document type:
+//
+//	archive.Fetchers{"glbatch": batches, "invoice": invoices}
+type Fetchers map[string]Fetcher
+
+func (f Fetchers) Fetch(ctx context.Context, docType, id string) (interface{}, error) {
+	fetcher, ok := f[docType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported document type \"%s\"", docType)
+	}
+	return fetcher.Fetch(ctx, docType, id)
+}
+
+// Problem is a single verification failure
+type Problem struct {
+	FiscalYear int    `json:"fiscal_year"`
+	Path       string `json:"path"`
+	Message    string `json:"message"`
+}
+
+// VerifyReport is the outcome of Verify
+type VerifyReport struct {
+	Files   int `json:"files"`
+	Sampled int `json:"sampled"`
+	// Seed the samples were selected with, pass it to a next run to check
+	// the same documents again
+	Seed     int64     `json:"seed"`
+	Problems []Problem `json:"problems"`
+}
+
+func (r VerifyReport) OK() bool {
+	return len(r.Problems) == 0
+}
+
+// Verifier checks an archive written by Exporter
+type Verifier struct {
+	Dir string
+	// Optional. When set SampleSize random documents per fiscal year are
+	// fetched again and compared with the archived JSON.
+	Fetcher    Fetcher
+	SampleSize int
+	// Seed for selecting the samples, makes a verification run repeatable
+	Seed int64
+	// Key the archive was exported with, needed to compare encrypted samples
+	Key []byte
+}
+
+func NewVerifier(dir string) *Verifier {
+	return &Verifier{Dir: dir}
+}
+
+// Verify recalculates the checksum of every file in the indexes and compares
+// the sampled documents with the API
+func (v *Verifier) Verify(ctx context.Context) (VerifyReport, error) {
+	report := VerifyReport{Seed: v.Seed, Problems: []Problem{}}
+
+	years, err := v.years()
+	if err != nil {
+		return report, err
+	}
+
+	rnd := rand.New(rand.NewSource(v.Seed))
+	for _, year := range years {
+		index, err := v.readIndex(year)
+		if err != nil {
+			return report, err
+		}
+
+		for _, entry := range index.Entries {
+			report.Files++
+			path, err := within(filepath.Join(v.Dir, year), entry.Path)
+			if err != nil {
+				report.Problems = append(report.Problems, Problem{index.FiscalYear, entry.Path, err.Error()})
+				continue
+			}
+
+			b, err := ioutil.ReadFile(path)
+			if err != nil {
+				report.Problems = append(report.Problems, Problem{index.FiscalYear, entry.Path, err.Error()})
+				continue
+			}
+
+			if Checksum(b) != entry.SHA256 {
+				report.Problems = append(report.Problems, Problem{index.FiscalYear, entry.Path, "checksum mismatch"})
+			}
+		}
+
+		if v.Fetcher == nil || v.SampleSize <= 0 {
+			continue
+		}
+
+		docs := []IndexEntry{}
+		for _, entry := range index.Entries {
+			if isDocument(entry) {
+				docs = append(docs, entry)
+			}
+		}
+
+		rnd.Shuffle(len(docs), func(i, j int) { docs[i], docs[j] = docs[j], docs[i] })
+		if len(docs) > v.SampleSize {
+			docs = docs[:v.SampleSize]
+		}
+
+		for _, entry := range docs {
+			report.Sampled++
+			problem := v.compare(ctx, year, entry)
+			if problem != "" {
+				report.Problems = append(report.Problems, Problem{index.FiscalYear, entry.Path, problem})
+			}
+		}
+	}
+
+	return report, nil
+}
+
+func (v *Verifier) compare(ctx context.Context, year string, entry IndexEntry) string {
+	data, err := v.Fetcher.Fetch(ctx, entry.Type, entry.ID)
+	if err != nil {
+		return fmt.Sprintf("fetching document: %s", err)
+	}
+
+	fetched, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("encoding document: %s", err)
+	}
+
+	path, err := within(filepath.Join(v.Dir, year), entry.Path)
+	if err != nil {
+		return err.Error()
+	}
+
+	archived, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err.Error()
+	}
+
+	if entry.Encrypted {
+		if v.Key == nil {
+			return "archived document is encrypted and no key is set"
+		}
+		archived, err = Decrypt(v.Key, entry.Path, archived)
+		if err != nil {
+			return err.Error()
+		}
+	}
+
+	if !bytes.Equal(fetched, archived) {
+		return "archived document differs from API"
+	}
+
+	return ""
+}
+
+// isDocument reports whether entry is the JSON of a document rather than an
+// attachment. Indexes written before entries had a kind are told apart by
+// directory: documents are type/id.json, attachments type/id/name.
+func isDocument(entry IndexEntry) bool {
+	if entry.Kind != "" {
+		return entry.Kind == KindDocument
+	}
+	return strings.Count(entry.Path, "/") == 1
+}
+
+func (v *Verifier) years() ([]string, error) {
+	infos, err := ioutil.ReadDir(v.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	years := []string{}
+	for _, info := range infos {
+		if !info.IsDir() {
+			continue
+		}
+		_, err := os.Stat(filepath.Join(v.Dir, info.Name(), IndexFile))
+		if err == nil {
+			years = append(years, info.Name())
+		}
+	}
+
+	sort.Strings(years)
+	return years, nil
+}
+
+func (v *Verifier) readIndex(year string) (Index, error) {
+	index := Index{}
+	b, err := ioutil.ReadFile(filepath.Join(v.Dir, year, IndexFile))
+	if err != nil {
+		return index, err
+	}
+
+	err = json.Unmarshal(b, &index)
+	return index, err
+}
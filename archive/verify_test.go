@@ -0,0 +1,131 @@
+package archive_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/omniboost/go-merit-aktiva/archive"
+)
+
+type staticFetcher map[string]interface{}
+
+func (f staticFetcher) Fetch(ctx context.Context, docType, id string) (interface{}, error) {
+	return f[id], nil
+}
+
+func TestVerify(t *testing.T) {
+	dir, err := ioutil.TempDir("", "archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	source := staticSource{
+		{Type: "glbatch", ID: "1", Date: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), Data: map[string]string{"No": "1"}},
+		{
+			Type: "glbatch", ID: "2", Date: time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC), Data: map[string]string{"No": "2"},
+			// not a document, even though it's JSON
+			Attachments: []archive.Attachment{{Name: "export.json", Data: []byte("[]")}},
+		},
+	}
+
+	_, err = archive.NewExporter(dir, source).Export(context.Background(), time.Time{}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verifier := archive.NewVerifier(dir)
+	verifier.Fetcher = staticFetcher{"1": map[string]string{"No": "1"}, "2": map[string]string{"No": "2"}}
+	verifier.SampleSize = 3
+	verifier.Seed = 42
+
+	report, err := verifier.Verify(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.OK() || report.Files != 3 || report.Sampled != 2 || report.Seed != 42 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	err = ioutil.WriteFile(filepath.Join(dir, "2020", "glbatch", "2.json"), []byte("{}"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err = verifier.Verify(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Problems) != 2 {
+		t.Errorf("expected checksum and sample problem, got %+v", report.Problems)
+	}
+}
+
+func TestVerifyEncrypted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	key, err := archive.ParseKey(strings.Repeat("ab", archive.KeySize))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	source := staticSource{
+		{Type: "glbatch", ID: "1", Date: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), Data: map[string]string{"No": "1"}},
+	}
+
+	exporter := archive.NewExporter(dir, source)
+	exporter.Key = key
+	_, err = exporter.Export(context.Background(), time.Time{}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "2020", "glbatch", "1.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "No") {
+		t.Fatalf("expected an encrypted file, got %q", b)
+	}
+
+	plain, err := archive.Decrypt(key, "glbatch/1.json", b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(plain), `"No": "1"`) {
+		t.Errorf("unexpected document: %s", plain)
+	}
+	if _, err := archive.Decrypt(key, "glbatch/2.json", b); err == nil {
+		t.Error("expected decrypting under another path to fail")
+	}
+
+	verifier := archive.NewVerifier(dir)
+	verifier.Fetcher = staticFetcher{"1": map[string]string{"No": "1"}}
+	verifier.SampleSize = 1
+
+	report, err := verifier.Verify(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Problems) != 1 {
+		t.Errorf("expected a problem without key, got %+v", report.Problems)
+	}
+
+	verifier.Key = key
+	report, err = verifier.Verify(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.OK() || report.Files != 1 || report.Sampled != 1 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+}
@@ -0,0 +1,51 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+)
+
+// AttemptHook is called before every attempt of a request, including the
+// retry after a timestamp error or a 429 response, which middleware added
+// with Use doesn't see. It returns the request to send, e.g. with a tracing
+// span in its context, and a function that's called with the result of the
+// attempt. Attempts are numbered from 1, see Attempt.
+type AttemptHook func(req *http.Request, attempt int) (*http.Request, func(resp *http.Response, err error))
+
+type attemptKey struct{}
+
+// Attempt returns the number of the attempt the request with ctx belongs to,
+// 1 for the first try and 2 for the retry. It's 0 outside of Do.
+func Attempt(ctx context.Context) int {
+	n, _ := ctx.Value(attemptKey{}).(int)
+	return n
+}
+
+func (c *Client) SetAttemptHook(hook AttemptHook) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.attemptHook = hook
+}
+
+func (c *Client) AttemptHook() AttemptHook {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.attemptHook
+}
+
+// attempt sends the request as attempt n, running the attempt hook around it
+func (c *Client) attempt(req *http.Request, responseBody interface{}, n int) (*http.Response, error) {
+	req = req.WithContext(context.WithValue(req.Context(), attemptKey{}, n))
+
+	hook := c.AttemptHook()
+	if hook == nil {
+		return c.observedDo(req, responseBody)
+	}
+
+	req, done := hook(req, n)
+	httpResp, err := c.observedDo(req, responseBody)
+	if done != nil {
+		done(httpResp, err)
+	}
+	return httpResp, err
+}
@@ -0,0 +1,170 @@
+package aktiva
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by Do without sending the request while the
+// circuit breaker of the client is open
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// CircuitBreaker stops requests while Merit is down. Do calls Allow before
+// sending a request and Record with the result of the request, including its
+// retries.
+type CircuitBreaker interface {
+	Allow() error
+	Record(err error)
+}
+
+// SetCircuitBreaker puts breaker in front of all requests of the client, nil
+// disables it
+func (c *Client) SetCircuitBreaker(breaker CircuitBreaker) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.circuitBreaker = breaker
+}
+
+func (c *Client) CircuitBreaker() CircuitBreaker {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.circuitBreaker
+}
+
+// CircuitState is the state of a circuit breaker
+type CircuitState int
+
+const (
+	// CircuitClosed lets all requests through
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails all requests
+	CircuitOpen
+	// CircuitHalfOpen lets a single probe through
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("CircuitState(%d)", int(s))
+}
+
+// NewCircuitBreaker returns a breaker that opens after threshold consecutive
+// outage errors: 5xx responses, timeouts and connection failures. After
+// cooldown a single probe request is let through, which closes the breaker
+// when it succeeds and opens it again when it fails.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *ConsecutiveBreaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+
+	return &ConsecutiveBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// ConsecutiveBreaker is the circuit breaker returned by NewCircuitBreaker
+type ConsecutiveBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	state     CircuitState
+	openedAt  time.Time
+	probing   bool
+	now       func() time.Time
+}
+
+func (b *ConsecutiveBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == CircuitOpen && b.now().Sub(b.openedAt) >= b.cooldown {
+		b.state = CircuitHalfOpen
+	}
+
+	switch b.state {
+	case CircuitOpen:
+		return ErrCircuitOpen
+	case CircuitHalfOpen:
+		if b.probing {
+			return ErrCircuitOpen
+		}
+		b.probing = true
+	}
+	return nil
+}
+
+func (b *ConsecutiveBreaker) Record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	probe := b.probing
+	b.probing = false
+
+	// the caller gave up, this says nothing about Merit
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+
+	if !isOutage(err) {
+		b.failures = 0
+		b.state = CircuitClosed
+		return
+	}
+
+	b.failures++
+	if probe || b.failures >= b.threshold {
+		b.state = CircuitOpen
+		b.openedAt = b.now()
+	}
+}
+
+// State returns the current state of the breaker
+func (b *ConsecutiveBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == CircuitOpen && b.now().Sub(b.openedAt) >= b.cooldown {
+		return CircuitHalfOpen
+	}
+	return b.state
+}
+
+// isOutage reports whether err means Merit is unavailable rather than that it
+// rejected the request
+func isOutage(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	errorResponse := &ErrorResponse{}
+	if errors.As(err, &errorResponse) {
+		return errorResponse.Response != nil && errorResponse.Response.StatusCode >= http.StatusInternalServerError
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr)
+}
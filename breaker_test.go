@@ -0,0 +1,76 @@
+package aktiva_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	calls := 0
+	down := true
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if down {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"Message": "Maintenance"}`))
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+	breaker := aktiva.NewCircuitBreaker(2, 50*time.Millisecond)
+	c.SetCircuitBreaker(breaker)
+
+	req := c.NewGetTaxesRequest()
+	for i := 0; i < 3; i++ {
+		_, err := req.Do(context.Background())
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+		if i == 2 && !errors.Is(err, aktiva.ErrCircuitOpen) {
+			t.Errorf("expected the circuit to be open, got %v", err)
+		}
+	}
+
+	if calls != 2 || breaker.State() != aktiva.CircuitOpen {
+		t.Errorf("expected 2 calls and an open circuit, got %d and %s", calls, breaker.State())
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	down = false
+	if breaker.State() != aktiva.CircuitHalfOpen {
+		t.Errorf("expected a half-open circuit, got %s", breaker.State())
+	}
+
+	_, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if breaker.State() != aktiva.CircuitClosed {
+		t.Errorf("expected the probe to close the circuit, got %s", breaker.State())
+	}
+}
+
+func TestCircuitBreakerIgnoresRejections(t *testing.T) {
+	breaker := aktiva.NewCircuitBreaker(1, time.Minute)
+	rejected := &aktiva.ErrorResponse{Response: &http.Response{StatusCode: http.StatusBadRequest}}
+
+	breaker.Record(rejected)
+	breaker.Record(context.Canceled)
+	if breaker.Allow() != nil || breaker.State() != aktiva.CircuitClosed {
+		t.Errorf("expected 4xx responses not to open the circuit, got %s", breaker.State())
+	}
+}
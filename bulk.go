@@ -0,0 +1,104 @@
+package aktiva
+
+import (
+	"errors"
+	"net/http"
+)
+
+// BulkStatus is the outcome of a single document in a bulk operation
+type BulkStatus int
+
+const (
+	// BulkSucceeded means the document was accepted
+	BulkSucceeded BulkStatus = iota
+	// BulkRejected means Merit refused the document (validation error).
+	// Sending it again without changes will fail again.
+	BulkRejected
+	// BulkFailed means the document could not be processed because of a
+	// transport or server error. It can safely be sent again.
+	BulkFailed
+)
+
+func (s BulkStatus) String() string {
+	switch s {
+	case BulkSucceeded:
+		return "succeeded"
+	case BulkRejected:
+		return "rejected"
+	case BulkFailed:
+		return "failed"
+	}
+	return "unknown"
+}
+
+// BulkResult holds the outcome of the document at Index in a bulk operation
+type BulkResult struct {
+	Index  int
+	Status BulkStatus
+	Err    error
+}
+
+// NewBulkResult classifies the error returned for the document at index
+func NewBulkResult(index int, err error) BulkResult {
+	return BulkResult{
+		Index:  index,
+		Status: BulkStatusFromError(err),
+		Err:    err,
+	}
+}
+
+// BulkStatusFromError returns BulkSucceeded for a nil error, BulkRejected for
+// 4xx responses and recognized Merit rejections and BulkFailed for everything
+// else
+func BulkStatusFromError(err error) BulkStatus {
+	if err == nil {
+		return BulkSucceeded
+	}
+
+	errorResponse := &ErrorResponse{}
+	if !errors.As(err, &errorResponse) {
+		return BulkFailed
+	}
+
+	if IsInvalidTimestampError(err) {
+		return BulkFailed
+	}
+
+	if errorResponse.Response == nil {
+		return BulkFailed
+	}
+
+	c := errorResponse.Response.StatusCode
+	if c >= 400 && c < 500 && c != http.StatusTooManyRequests {
+		return BulkRejected
+	}
+
+	return BulkFailed
+}
+
+type BulkResults []BulkResult
+
+func (rr BulkResults) indexes(status BulkStatus) []int {
+	indexes := []int{}
+	for _, r := range rr {
+		if r.Status == status {
+			indexes = append(indexes, r.Index)
+		}
+	}
+	return indexes
+}
+
+// Succeeded returns the indexes of the accepted documents
+func (rr BulkResults) Succeeded() []int {
+	return rr.indexes(BulkSucceeded)
+}
+
+// Rejected returns the indexes of the documents Merit refused
+func (rr BulkResults) Rejected() []int {
+	return rr.indexes(BulkRejected)
+}
+
+// Failed returns the indexes of the documents that should be sent again
+func (rr BulkResults) Failed() []int {
+	return rr.indexes(BulkFailed)
+}
@@ -0,0 +1,32 @@
+package aktiva_test
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestBulkResults(t *testing.T) {
+	rejected := &aktiva.ErrorResponse{Response: &http.Response{StatusCode: http.StatusBadRequest}}
+	unavailable := &aktiva.ErrorResponse{Response: &http.Response{StatusCode: http.StatusServiceUnavailable}}
+
+	results := aktiva.BulkResults{
+		aktiva.NewBulkResult(0, nil),
+		aktiva.NewBulkResult(1, rejected),
+		aktiva.NewBulkResult(2, unavailable),
+		aktiva.NewBulkResult(3, errors.New("connection reset by peer")),
+	}
+
+	if !reflect.DeepEqual(results.Succeeded(), []int{0}) {
+		t.Errorf("unexpected succeeded: %v", results.Succeeded())
+	}
+	if !reflect.DeepEqual(results.Rejected(), []int{1}) {
+		t.Errorf("unexpected rejected: %v", results.Rejected())
+	}
+	if !reflect.DeepEqual(results.Failed(), []int{2, 3}) {
+		t.Errorf("unexpected failed: %v", results.Failed())
+	}
+}
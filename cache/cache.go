@@ -0,0 +1,239 @@
+// Package cache keeps the responses of reference endpoints (taxes, accounts,
+// items, ...) that rarely change, so posting an invoice doesn't fetch them
+// again every time. Install it as middleware:
+//
+//	c := cache.New(cache.NewMemoryStore(), time.Hour)
+//	client.Use(c.Middleware())
+package cache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+// DefaultEndpoints are the reference endpoints cached when New gets none
+var DefaultEndpoints = []string{
+	"getaccounts",
+	"getbanks",
+	"getcostcenters",
+	"getcurrencies",
+	"getdepartments",
+	"getdimensions",
+	"getdimvalues",
+	"getitemgroups",
+	"getitems",
+	"getlocations",
+	"getprojects",
+	"gettaxes",
+	"getunits",
+}
+
+// Store keeps the encoded responses. Implementations must be safe for
+// concurrent use.
+type Store interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte, ttl time.Duration)
+	Delete(key string)
+}
+
+// Cache caches the responses of its endpoints
+type Cache struct {
+	store     Store
+	ttl       time.Duration
+	endpoints map[string]bool
+}
+
+// New caches the responses of endpoints for ttl, DefaultEndpoints when none
+// are given
+func New(store Store, ttl time.Duration, endpoints ...string) *Cache {
+	if len(endpoints) == 0 {
+		endpoints = DefaultEndpoints
+	}
+
+	c := &Cache{
+		store:     store,
+		ttl:       ttl,
+		endpoints: map[string]bool{},
+	}
+	for _, e := range endpoints {
+		c.endpoints[e] = true
+	}
+	return c
+}
+
+// Middleware returns the middleware serving the cached responses
+func (c *Cache) Middleware() aktiva.Middleware {
+	return func(next aktiva.HandlerFunc) aktiva.HandlerFunc {
+		return func(req *http.Request, responseBody interface{}) (*http.Response, error) {
+			endpoint := path.Base(req.URL.Path)
+			if !c.endpoints[endpoint] || responseBody == nil {
+				return next(req, responseBody)
+			}
+
+			// raw bodies are streamed, not cached
+			if _, ok := responseBody.(io.Writer); ok {
+				return next(req, responseBody)
+			}
+
+			key, err := Key(req)
+			if err != nil {
+				return next(req, responseBody)
+			}
+
+			if data, ok := c.store.Get(key); ok {
+				err := json.Unmarshal(data, responseBody)
+				if err == nil {
+					return cachedResponse(req), nil
+				}
+				c.store.Delete(key)
+			}
+
+			resp, err := next(req, responseBody)
+			if err != nil {
+				return resp, err
+			}
+
+			data, err := json.Marshal(responseBody)
+			if err == nil {
+				c.store.Set(key, data, c.ttl)
+			}
+			return resp, nil
+		}
+	}
+}
+
+// cachedResponse is returned instead of the response of Merit on a cache hit
+func cachedResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"X-Cache": []string{"hit"}},
+		Body:       http.NoBody,
+		Request:    req,
+	}
+}
+
+// signatureParams change on every request and aren't part of the key
+var signatureParams = map[string]bool{
+	"timestamp": true,
+	"signature": true,
+}
+
+// Key returns the cache key of a request: the scheme and host of the API
+// (region or sandbox), the company (API ID), endpoint, query parameters and
+// body
+func Key(req *http.Request) (string, error) {
+	h := sha256.New()
+
+	query := req.URL.Query()
+	keys := []string{}
+	for k := range query {
+		if !signatureParams[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	io.WriteString(h, req.URL.Scheme+"://"+strings.ToLower(req.URL.Host)+"\n")
+	io.WriteString(h, query.Get("ApiId")+"\n")
+	io.WriteString(h, req.URL.Path+"\n")
+	for _, k := range keys {
+		io.WriteString(h, k+"="+strings.Join(query[k], ",")+"\n")
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return "", err
+		}
+		defer body.Close()
+
+		var r io.Reader = body
+		if req.Header.Get("Content-Encoding") == "gzip" {
+			gz, err := gzip.NewReader(body)
+			if err != nil {
+				return "", err
+			}
+			defer gz.Close()
+			r = gz
+		}
+
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return "", err
+		}
+		h.Write(bytes.TrimSpace(data))
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// MemoryStore is an in-memory Store with expiring entries
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[string]entry
+	now     func() time.Time
+}
+
+type entry struct {
+	value   []byte
+	expires time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries: map[string]entry{},
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryStore) Get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if !s.now().Before(e.expires) {
+		delete(s.entries, key)
+		return nil, false
+	}
+	return e.value, true
+}
+
+func (s *MemoryStore) Set(key string, value []byte, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = entry{value: value, expires: s.now().Add(ttl)}
+}
+
+func (s *MemoryStore) Delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, key)
+}
+
+// Clear removes all entries, e.g. after changing reference data in Merit
+func (s *MemoryStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = map[string]entry{}
+}
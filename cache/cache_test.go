@@ -0,0 +1,84 @@
+package cache_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+	"github.com/omniboost/go-merit-aktiva/cache"
+)
+
+func TestCache(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"Code": "VAT20"}]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+	c.Use(cache.New(cache.NewMemoryStore(), time.Hour).Middleware())
+
+	for i := 0; i < 2; i++ {
+		req := c.NewGetTaxesRequest()
+		resp, err := req.Do(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp) != 1 || resp[0].Code != "VAT20" {
+			t.Errorf("unexpected response %v", resp)
+		}
+	}
+
+	// other company
+	other := c.WithCredentials("other", "key")
+	req := other.NewGetTaxesRequest()
+	_, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if calls != 2 {
+		t.Errorf("expected 2 calls to Merit, got %d", calls)
+	}
+}
+
+func TestKeyIncludesHost(t *testing.T) {
+	keys := map[string]bool{}
+	for _, u := range []string{
+		"https://aktiva.merit.ee/api/v1/gettaxes?ApiId=id",
+		"https://program.360ksiegowosc.pl/api/v1/gettaxes?ApiId=id",
+		"http://aktiva.merit.ee/api/v1/gettaxes?ApiId=id",
+	} {
+		req, _ := http.NewRequest(http.MethodGet, u, nil)
+		key, err := cache.Key(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[key] = true
+	}
+
+	if len(keys) != 3 {
+		t.Errorf("expected 3 different keys, got %d", len(keys))
+	}
+}
+
+func TestMemoryStoreExpiry(t *testing.T) {
+	store := cache.NewMemoryStore()
+	store.Set("a", []byte("1"), 0)
+	store.Set("b", []byte("2"), time.Hour)
+
+	if _, ok := store.Get("a"); ok {
+		t.Error("expected a to be expired")
+	}
+	if v, ok := store.Get("b"); !ok || string(v) != "2" {
+		t.Errorf("expected b, got %q", v)
+	}
+}
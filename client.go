@@ -5,22 +5,25 @@ import (
 	"context"
 	"crypto/hmac"
 	"crypto/sha256"
+	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+	"path"
+	"regexp"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"text/template"
 	"time"
 
 	ntlmssp "github.com/Azure/go-ntlmssp"
-	"github.com/omniboost/go-merit-aktiva/utils"
 )
 
 const (
@@ -28,6 +31,8 @@ const (
 	userAgent      = "go-merit-aktiva/" + libraryVersion
 	mediaType      = "application/json"
 	charset        = "utf-8"
+
+	defaultMaxRetryAfter = time.Minute
 )
 
 var (
@@ -38,13 +43,37 @@ var (
 	}
 )
 
+// APIVersion is the version segment of the endpoint URLs
+type APIVersion string
+
+const (
+	APIVersion1 APIVersion = "v1"
+	APIVersion2 APIVersion = "v2"
+)
+
+// AuthMode determines how requests are authenticated
+type AuthMode int
+
+const (
+	// AuthModeSignature only signs the query with the API key
+	AuthModeSignature AuthMode = iota
+	// AuthModeNTLM also negotiates NTLM, using the basic auth credentials of
+	// the request
+	AuthModeNTLM
+)
+
 // NewClient returns a new Exact Globe Client client
 func NewClient(httpClient *http.Client, apiID, apiKey string) *Client {
+	// own the transport so SetTransportOptions doesn't change other users of
+	// http.DefaultTransport
 	if httpClient == nil {
-		httpClient = http.DefaultClient
+		httpClient = &http.Client{Transport: newTransport(TransportOptions{})}
 	}
 
-	client := &Client{}
+	client := &Client{
+		mu:       &sync.RWMutex{},
+		features: &features{},
+	}
 
 	client.SetHTTPClient(httpClient)
 	client.SetAPIID(apiID)
@@ -54,14 +83,36 @@ func NewClient(httpClient *http.Client, apiID, apiKey string) *Client {
 	client.SetUserAgent(userAgent)
 	client.SetMediaType(mediaType)
 	client.SetCharset(charset)
+	client.SetMaxRetryAfter(defaultMaxRetryAfter)
+	client.SetEncoder(nil)
+	client.SetLogger(NewStdLogger(nil, LogLevelDebug))
 
 	return client
 }
 
-// Client manages communication with Exact Globe Client
+// Client manages communication with Exact Globe Client.
+//
+// A client is safe for concurrent use. The setters can be called while
+// requests are running, a request uses the settings of the moment it is
+// created or sent.
 type Client struct {
+	// number of requests that were re-signed and retried after Merit rejected
+	// the timestamp. Kept first for 64-bit alignment of atomic operations.
+	timestampRetries int64
+	// nanoseconds the clock of Merit is ahead of the local clock
+	clockSkew int64
+
+	// guards the configuration below
+	mu *sync.RWMutex
+
 	// HTTP client used to communicate with the Client.
 	http *http.Client
+	// transport of http before the authentication wrapping
+	transport http.RoundTripper
+	// applied to a copy of transport
+	proxy     *url.URL
+	tlsConfig *tls.Config
+	authMode  AuthMode
 
 	debug   bool
 	baseURL url.URL
@@ -69,6 +120,11 @@ type Client struct {
 	// credentials
 	apiID  string
 	apiKey string
+	signer Signer
+	// encodes the request bodies
+	encoder Encoder
+	// receives the debug dumps and retry messages
+	logger Logger
 
 	// User agent for client
 	userAgent string
@@ -77,172 +133,667 @@ type Client struct {
 	charset               string
 	disallowUnknownFields bool
 
+	// allow requests from the experimental package
+	experimental bool
+
+	// time zone Merit interprets document dates and timestamps in
+	location *time.Location
+	// returns the current time for timestamps
+	clock func() time.Time
+	// gzip request bodies
+	compressRequests bool
+	// throttles requests to the API quota
+	rateLimiter RateLimiter
+	// longest Retry-After of a throttled request that is waited for
+	maxRetryAfter time.Duration
+
 	// Optional function called after every successful request made to the DO Clients
 	onRequestCompleted RequestCompletionCallback
+	// wraps the execution of requests
+	middleware []Middleware
+	// receives the metrics of every request attempt
+	metricsCollector MetricsCollector
+	// runs around every request attempt, e.g. for tracing
+	attemptHook AttemptHook
+	// fails requests fast while Merit is down
+	circuitBreaker CircuitBreaker
+	// builds write requests without sending them
+	dryRun     bool
+	dryRunFunc DryRunFunc
+
+	// endpoints that aren't available in the company's plan
+	features *features
 }
 
-// RequestCompletionCallback defines the type of the request callback function
+// Clone returns a copy of the client with the same settings. The copy shares
+// the HTTP client, and so its connection pool, but has its own feature
+// availability and retry counters.
+func (c *Client) Clone() *Client {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return &Client{
+		clockSkew: atomic.LoadInt64(&c.clockSkew),
+		mu:        &sync.RWMutex{},
+		features:  &features{},
+
+		http:                  c.http,
+		transport:             c.transport,
+		proxy:                 c.proxy,
+		tlsConfig:             c.tlsConfig,
+		authMode:              c.authMode,
+		debug:                 c.debug,
+		baseURL:               c.baseURL,
+		apiID:                 c.apiID,
+		apiKey:                c.apiKey,
+		signer:                c.signer,
+		encoder:               c.encoder,
+		logger:                c.logger,
+		userAgent:             c.userAgent,
+		mediaType:             c.mediaType,
+		charset:               c.charset,
+		disallowUnknownFields: c.disallowUnknownFields,
+		experimental:          c.experimental,
+		location:              c.location,
+		clock:                 c.clock,
+		rateLimiter:           c.rateLimiter,
+		maxRetryAfter:         c.maxRetryAfter,
+		compressRequests:      c.compressRequests,
+		onRequestCompleted:    c.onRequestCompleted,
+		middleware:            c.middleware,
+		metricsCollector:      c.metricsCollector,
+		attemptHook:           c.attemptHook,
+		circuitBreaker:        c.circuitBreaker,
+		dryRun:                c.dryRun,
+		dryRunFunc:            c.dryRunFunc,
+	}
+}
+
+// WithCredentials returns a clone of the client for another company. Use it
+// to serve many companies over one connection pool. A rate limiter set on the
+// client is shared, set a new one for per-company quotas.
+func (c *Client) WithCredentials(apiID, apiKey string) *Client {
+	clone := c.Clone()
+	clone.SetAPIID(apiID)
+	clone.SetAPIKey(apiKey)
+	return clone
+}
+
+// RequestCompletionCallback defines the type of the request callback function.
+// RequestID(req) returns the ID of the request.
 type RequestCompletionCallback func(*http.Request, *http.Response)
 
+// SetHTTPClient sets the HTTP client used for requests. The client is copied,
+// so the transport wrapping done here doesn't affect other users of it.
 func (c *Client) SetHTTPClient(client *http.Client) {
-	// set NTLM authentication
-	client.Transport = ntlmssp.Negotiator{
-		RoundTripper: http.DefaultTransport,
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	httpClient := *client
+	c.http = &httpClient
+	c.transport = client.Transport
+	if c.transport == nil {
+		c.transport = http.DefaultTransport
+	}
+	c.applyTransport()
+}
+
+// SetTransport sets the round tripper of the HTTP client used for requests,
+// http.DefaultTransport when nil
+func (c *Client) SetTransport(transport http.RoundTripper) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	c.transport = transport
+	c.applyTransport()
+}
+
+// AuthMode returns how requests are authenticated
+func (c *Client) AuthMode() AuthMode {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.authMode
+}
+
+// SetAuthMode sets how requests are authenticated. The public API only needs
+// the signature, AuthModeNTLM is for on-premise installations behind NTLM.
+func (c *Client) SetAuthMode(authMode AuthMode) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.authMode = authMode
+	c.applyTransport()
+}
+
+// applyTransport sets the transport on a copy of the HTTP client, so a client
+// shared with SetHTTPClient isn't changed. The caller holds the lock.
+func (c *Client) applyTransport() {
+	if c.http == nil || c.transport == nil {
+		return
+	}
+
+	transport := c.networkTransport()
+
+	httpClient := *c.http
+	httpClient.Transport = transport
+	if c.authMode == AuthModeNTLM {
+		httpClient.Transport = ntlmssp.Negotiator{
+			RoundTripper: transport,
+		}
 	}
 
-	c.http = client
+	c.http = &httpClient
+}
+
+func (c *Client) httpClient() *http.Client {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.http
 }
 
-func (c Client) Debug() bool {
+func (c *Client) Debug() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.debug
 }
 
 func (c *Client) SetDebug(debug bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.debug = debug
 }
 
-func (c Client) APIID() string {
+func (c *Client) APIID() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.apiID
 }
 
 func (c *Client) SetAPIID(apiID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.apiID = apiID
 }
 
-func (c Client) APIKey() string {
+func (c *Client) APIKey() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.apiKey
 }
 
 func (c *Client) SetAPIKey(apiKey string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.apiKey = apiKey
 }
 
-func (c Client) BaseURL() url.URL {
+// credentials returns the API ID and key as one consistent pair
+func (c *Client) credentials() (string, string) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.apiID, c.apiKey
+}
+
+func (c *Client) BaseURL() url.URL {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.baseURL
 }
 
 func (c *Client) SetBaseURL(baseURL url.URL) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.baseURL = baseURL
 }
 
+// APIVersion returns the API version of the base URL, the last segment of
+// its path
+func (c *Client) APIVersion() APIVersion {
+	baseURL := c.BaseURL()
+	return APIVersion(path.Base(baseURL.Path))
+}
+
+// SetAPIVersion changes the version segment of the base URL path, e.g.
+// /api/v1/ becomes /api/v2/. Requests that only exist in a specific version
+// use that version regardless of this setting.
+func (c *Client) SetAPIVersion(version APIVersion) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.baseURL = withVersion(c.baseURL, version)
+}
+
+// versionedBaseURL returns the base URL with its version segment replaced
+func (c *Client) versionedBaseURL(version APIVersion) url.URL {
+	return withVersion(c.BaseURL(), version)
+}
+
+func withVersion(baseURL url.URL, version APIVersion) url.URL {
+	root := path.Dir(strings.TrimSuffix(baseURL.Path, "/"))
+	baseURL.Path = strings.TrimSuffix(root, "/") + "/" + string(version) + "/"
+	return baseURL
+}
+
 func (c *Client) SetMediaType(mediaType string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.mediaType = mediaType
 }
 
-func (c Client) MediaType() string {
-	return mediaType
+func (c *Client) MediaType() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.mediaType
 }
 
 func (c *Client) SetCharset(charset string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.charset = charset
 }
 
-func (c Client) Charset() string {
-	return charset
+func (c *Client) Charset() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.charset
 }
 
 func (c *Client) SetUserAgent(userAgent string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.userAgent = userAgent
 }
 
-func (c Client) UserAgent() string {
-	return userAgent
+func (c *Client) UserAgent() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.userAgent
+}
+
+// Location returns the time zone used for timestamps and for dates created
+// with Date and DateTime. Defaults to time.Local.
+func (c *Client) Location() *time.Location {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.location == nil {
+		return time.Local
+	}
+	return c.location
+}
+
+// SetLocation sets the time zone Merit interprets dates in, e.g. the result of
+// time.LoadLocation("Europe/Tallinn") for Estonian companies
+func (c *Client) SetLocation(location *time.Location) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.location = location
+}
+
+// Date returns the calendar day of t in the client's location
+func (c *Client) Date(t time.Time) Date {
+	location := c.Location()
+	t = t.In(location)
+	return Date{time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, location)}
+}
+
+// DateTime returns t in the client's location
+func (c *Client) DateTime(t time.Time) DateTime {
+	return DateTime{t.In(c.Location())}
+}
+
+// SetClock sets the function returning the current time used for timestamps,
+// time.Now when nil. Use it for deterministic signatures in tests or to
+// correct a skewed system clock.
+func (c *Client) SetClock(clock func() time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.clock = clock
+}
+
+func (c *Client) now() time.Time {
+	c.mu.RLock()
+	clock := c.clock
+	c.mu.RUnlock()
+
+	if clock == nil {
+		return time.Now()
+	}
+	return clock()
+}
+
+func (c *Client) GenerateTimestamp() Timestamp {
+	return NewTimestamp(c.now().Add(c.ClockSkew()).In(c.Location()))
 }
 
-func (c Client) GenerateTimestamp() DateTime {
-	return DateTime{time.Now()}
+// GenerateSignature returns the base64 encoded HMAC-SHA256, keyed with the API
+// key, of the API ID, the timestamp and the request body
+func (c *Client) GenerateSignature(timestamp Timestamp, body *bytes.Buffer) string {
+	apiID, apiKey := c.credentials()
+	return generateSignature(apiID, apiKey, timestamp, body)
 }
 
-func (c *Client) GenerateSignature(timestamp DateTime, body *bytes.Buffer) string {
-	h := hmac.New(sha256.New, []byte(c.APIKey()))
+func generateSignature(apiID, apiKey string, timestamp Timestamp, body *bytes.Buffer) string {
+	return base64.StdEncoding.EncodeToString(signatureMAC(apiID, apiKey, timestamp.String(), body.Bytes()))
+}
+
+func signatureMAC(apiID, apiKey, timestamp string, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(apiKey))
 	data := []byte{}
-	data = append(data, []byte(c.APIID())...)
-	data = append(data, []byte(timestamp.String())...)
-	data = append(data, body.Bytes()...)
+	data = append(data, []byte(apiID)...)
+	data = append(data, []byte(timestamp)...)
+	data = append(data, body...)
 	h.Write(data)
-	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+	return h.Sum(nil)
+}
+
+// Experimental reports whether requests from the experimental package are
+// allowed
+func (c *Client) Experimental() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.experimental
+}
+
+// SetExperimental enables the requests in the experimental package. Those
+// endpoints are undocumented and their request and response types may change
+// in any release.
+func (c *Client) SetExperimental(experimental bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.experimental = experimental
 }
 
 func (c *Client) SetDisallowUnknownFields(disallowUnknownFields bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.disallowUnknownFields = disallowUnknownFields
 }
 
-func (c *Client) GetEndpointURL(path string, pathParams PathParams) url.URL {
-	clientURL := c.BaseURL()
-	clientURL.Path = clientURL.Path + path
+func (c *Client) DisallowUnknownFields() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.disallowUnknownFields
+}
+
+// SetOnRequestCompleted sets a function called after every request that got a
+// response
+func (c *Client) SetOnRequestCompleted(callback RequestCompletionCallback) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onRequestCompleted = callback
+}
+
+// OnRequestCompleted returns the function set with SetOnRequestCompleted, so
+// it can be chained
+func (c *Client) OnRequestCompleted() RequestCompletionCallback {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.onRequestCompleted
+}
 
-	tmpl, err := template.New("endpoint_url").Parse(clientURL.Path)
+func (c *Client) GetEndpointURL(path string, pathParams PathParams) (url.URL, error) {
+	return c.endpointURL(c.BaseURL(), path, pathParams)
+}
+
+// GetVersionedEndpointURL returns the URL of an endpoint that only exists in
+// a specific API version
+func (c *Client) GetVersionedEndpointURL(version APIVersion, path string, pathParams PathParams) (url.URL, error) {
+	return c.endpointURL(c.versionedBaseURL(version), path, pathParams)
+}
+
+// endpointURL fills in the path parameters of the {{.name}} placeholders in
+// path. Parameter values are escaped, missing parameters are an error.
+func (c *Client) endpointURL(clientURL url.URL, path string, pathParams PathParams) (url.URL, error) {
+	tmpl, err := template.New("endpoint_url").Option("missingkey=error").Parse(clientURL.Path + path)
 	if err != nil {
-		log.Fatal(err)
+		return clientURL, &EndpointError{Endpoint: path, Err: err}
 	}
 
-	buf := new(bytes.Buffer)
 	params := pathParams.Params()
+	escaped := make(map[string]string, len(params))
+	for k, v := range params {
+		escaped[k] = url.PathEscape(v)
+	}
+
+	buf := new(bytes.Buffer)
 	err = tmpl.Execute(buf, params)
 	if err != nil {
-		log.Fatal(err)
+		return clientURL, &EndpointError{Endpoint: path, Err: err}
 	}
-
 	clientURL.Path = buf.String()
-	return clientURL
+
+	buf.Reset()
+	err = tmpl.Execute(buf, escaped)
+	if err != nil {
+		return clientURL, &EndpointError{Endpoint: path, Err: err}
+	}
+	clientURL.RawPath = buf.String()
+
+	return clientURL, nil
 }
 
+// Endpoint returns the name of the endpoint URL points to, e.g. "getinvoices"
+func (c *Client) Endpoint(URL url.URL) string {
+	return path.Base(URL.Path)
+}
+
+// NewRequest encodes and signs a request. The context is required, it's used
+// for the whole request including retries and reading the response body.
 func (c *Client) NewRequest(ctx context.Context, method string, URL url.URL, body interface{}) (*http.Request, error) {
+	if ctx == nil {
+		return nil, &EndpointError{Endpoint: c.Endpoint(URL), Err: errors.New("nil context")}
+	}
+
+	// don't do any work when the request is already cancelled
+	if ctx.Err() != nil {
+		return nil, &EndpointError{Endpoint: c.Endpoint(URL), Err: ctx.Err()}
+	}
+
 	// convert body struct to json
 	buf := new(bytes.Buffer)
 	if body != nil {
-		err := json.NewEncoder(buf).Encode(body)
+		err := c.Encoder().Encode(buf, body)
 		if err != nil {
-			return nil, err
+			return nil, &EndpointError{Endpoint: c.Endpoint(URL), Err: err}
 		}
 	}
 
-	// create new http request
-	req, err := http.NewRequest(method, URL.String(), buf)
+	// create new http request, keeping the encoded body so it can be read
+	// again for retries, redirects and debug dumps
+	req, err := http.NewRequestWithContext(ctx, method, URL.String(), nil)
 	if err != nil {
-		return nil, err
+		return nil, &EndpointError{Endpoint: c.Endpoint(URL), Err: err}
 	}
 
-	values := url.Values{}
-	values.Add("ApiId", c.APIID())
-	timestamp := c.GenerateTimestamp()
-	values.Add("timestamp", timestamp.String())
-	values.Add("signature", c.GenerateSignature(timestamp, buf))
-
-	err = utils.AddURLValuesToRequest(values, req, true)
+	err = setRequestBody(req, buf.Bytes(), c.CompressRequests())
 	if err != nil {
-		return nil, err
+		return nil, &EndpointError{Endpoint: c.Endpoint(URL), Err: err}
 	}
 
-	// optionally pass along context
-	if ctx != nil {
-		req = req.WithContext(ctx)
+	err = c.SignRequest(req, buf)
+	if err != nil {
+		return nil, &EndpointError{Endpoint: c.Endpoint(URL), Err: err}
 	}
 
 	// set other headers
 	req.Header.Add("Content-Type", fmt.Sprintf("%s; charset=%s", c.MediaType(), c.Charset()))
 	req.Header.Add("Accept", c.MediaType())
 	req.Header.Add("User-Agent", c.UserAgent())
+	req.Header.Add("Accept-Encoding", "gzip")
+
+	options := optionsFromContext(ctx)
+	requestID := options.requestID
+	if requestID == "" {
+		requestID = newRequestID()
+	}
+	req.Header.Set(RequestIDHeader, requestID)
+
+	// headers of the request options
+	for k, values := range options.headers {
+		for _, v := range values {
+			req.Header.Add(k, v)
+		}
+	}
 
 	return req, nil
 }
 
+// SignRequest authenticates the request with the client's Signer. The default
+// signer adds the ApiId, timestamp and signature query parameters, replacing
+// existing values so a request can be signed again.
+func (c *Client) SignRequest(req *http.Request, body *bytes.Buffer) error {
+	return c.Signer().Sign(req, body)
+}
+
+// TimestampRetries returns the number of requests that were re-signed and
+// retried because Merit rejected the timestamp
+func (c *Client) TimestampRetries() int64 {
+	return atomic.LoadInt64(&c.timestampRetries)
+}
+
 // Do sends an Client request and returns the Client response. The Client response is json decoded and stored in the value
 // pointed to by v, or returned as an error if an Client error has occurred. If v implements the io.Writer interface,
 // the raw response will be written to v, without attempting to decode it.
+//
+// When Merit rejects the request because of an invalid timestamp (clock skew)
+// the request is signed again with a fresh timestamp and retried once.
+//
+// Endpoints that Merit reports as not part of the company's plan are marked
+// unavailable and return a FeatureUnavailableError from then on without
+// calling Merit.
+//
+// Errors are wrapped in an EndpointError.
 func (c *Client) Do(req *http.Request, responseBody interface{}) (*http.Response, error) {
-	if c.debug == true {
+	endpoint := c.Endpoint(*req.URL)
+	if !c.features.available(endpoint) {
+		err := &FeatureUnavailableError{Endpoint: endpoint}
+		return nil, &EndpointError{Endpoint: endpoint, Err: err}
+	}
+
+	if c.DryRun() && isWriteEndpoint(endpoint) {
+		httpResp, err := c.doDryRun(req)
+		if err != nil {
+			return httpResp, &EndpointError{Endpoint: endpoint, RequestID: RequestID(req), Err: err}
+		}
+		return httpResp, nil
+	}
+
+	breaker := c.CircuitBreaker()
+	if breaker != nil {
+		err := breaker.Allow()
+		if err != nil {
+			return nil, &EndpointError{Endpoint: endpoint, RequestID: RequestID(req), Err: err}
+		}
+	}
+
+	httpResp, err := c.handler()(req, responseBody)
+	if breaker != nil {
+		breaker.Record(err)
+	}
+	if isFeatureUnavailableResponse(err) {
+		c.features.markUnavailable(endpoint)
+		err = &FeatureUnavailableError{Endpoint: endpoint, Err: err}
+	}
+
+	if err != nil {
+		return httpResp, &EndpointError{Endpoint: endpoint, RequestID: RequestID(req), Err: err}
+	}
+	return httpResp, nil
+}
+
+func (c *Client) doWithRetry(req *http.Request, responseBody interface{}) (*http.Response, error) {
+	httpResp, err := c.attempt(req, responseBody, 1)
+	if err == nil || req.GetBody == nil {
+		return httpResp, err
+	}
+
+	if IsInvalidTimestampError(err) {
+		atomic.AddInt64(&c.timestampRetries, 1)
+		c.measureClockSkew(err)
+	} else if !c.waitRetryAfter(req.Context(), err) {
+		return httpResp, err
+	}
+
+	c.Logger().Warn("retrying request", "endpoint", path.Base(req.URL.Path), "request_id", RequestID(req), "error", err)
+	err = c.resignRequest(req)
+	if err != nil {
+		return httpResp, err
+	}
+
+	return c.attempt(req, responseBody, 2)
+}
+
+// resignRequest signs the request again with a new timestamp and resets its
+// body so it can be sent again
+func (c *Client) resignRequest(req *http.Request) error {
+	payload, err := requestPayload(req)
+	if err != nil {
+		return err
+	}
+
+	err = c.SignRequest(req, bytes.NewBuffer(payload))
+	if err != nil {
+		return err
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+
+	req.Body = body
+	return nil
+}
+
+func (c *Client) do(req *http.Request, responseBody interface{}) (*http.Response, error) {
+	// don't send requests that are already cancelled
+	err := req.Context().Err()
+	if err != nil {
+		return nil, err
+	}
+
+	if rateLimiter := c.RateLimiter(); rateLimiter != nil {
+		err = rateLimiter.Wait(req.Context())
+		if err != nil {
+			return nil, err
+		}
+
+		// the timestamp may be too old after waiting
+		if req.GetBody != nil {
+			err = c.resignRequest(req)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	options := optionsFromContext(req.Context())
+	debug := c.Debug()
+	if options.debug != nil {
+		debug = *options.debug
+	}
+	if debug == true {
 		dump, _ := httputil.DumpRequestOut(req, true)
-		log.Println(string(dump))
+		c.Logger().Debug("request", "request_id", RequestID(req), "dump", string(redactDump(dump)))
 	}
 
-	httpResp, err := c.http.Do(req)
+	httpResp, err := c.httpClient().Do(req)
 	if err != nil {
 		return nil, err
 	}
 
-	if c.onRequestCompleted != nil {
-		c.onRequestCompleted(req, httpResp)
+	err = decompressResponse(httpResp)
+	if err != nil {
+		httpResp.Body.Close()
+		return httpResp, err
+	}
+
+	if callback := c.OnRequestCompleted(); callback != nil {
+		callback(req, httpResp)
 	}
 
 	// close body io.Reader
@@ -252,13 +803,24 @@ func (c *Client) Do(req *http.Request, responseBody interface{}) (*http.Response
 		}
 	}()
 
-	if c.debug == true {
+	if debug == true {
 		dump, _ := httputil.DumpResponse(httpResp, true)
-		log.Println(string(dump))
+		c.Logger().Debug("response", "request_id", RequestID(req), "dump", string(redactDump(dump)))
 	}
 
+	// count the bytes of the body for the metrics collector
+	counted := &countingBody{ReadCloser: httpResp.Body}
+	httpResp.Body = counted
+
 	// check if the response isn't an error
 	err = CheckResponse(httpResp)
+	if httpResp.Body != io.ReadCloser(counted) {
+		// CheckResponse read the error body and replaced it with a copy
+		httpResp.Body = &replayedBody{Reader: httpResp.Body, original: counted}
+	}
+	if err != nil && httpResp.StatusCode == http.StatusTooManyRequests {
+		return httpResp, newRateLimitError(httpResp, err)
+	}
 	if err != nil {
 		return httpResp, err
 	}
@@ -273,24 +835,38 @@ func (c *Client) Do(req *http.Request, responseBody interface{}) (*http.Response
 	}
 
 	// interface implements io.Writer: write Body to it
-	// if w, ok := response.Envelope.(io.Writer); ok {
-	// 	_, err := io.Copy(w, httpResp.Body)
-	// 	return httpResp, err
-	// }
+	if w, ok := responseBody.(io.Writer); ok {
+		_, err := io.Copy(w, httpResp.Body)
+		if err != nil && req.Context().Err() != nil {
+			return httpResp, req.Context().Err()
+		}
+		return httpResp, err
+	}
+
+	// read the body first so a decode error can show where it failed
+	body, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil && req.Context().Err() != nil {
+		// cancelled while reading the body
+		return httpResp, req.Context().Err()
+	}
+	if err != nil {
+		return httpResp, err
+	}
 
 	// try to decode body into interface parameter
 	// w := &Wrapper{}
-	dec := json.NewDecoder(httpResp.Body)
-	if c.disallowUnknownFields {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	disallowUnknownFields := c.DisallowUnknownFields()
+	if options.disallowUnknownFields != nil {
+		disallowUnknownFields = *options.disallowUnknownFields
+	}
+	if disallowUnknownFields {
 		dec.DisallowUnknownFields()
 	}
 
 	err = dec.Decode(responseBody)
 	if err != nil && err != io.EOF {
-		// create a simple error response
-		errorResponse := &ErrorResponse{Response: httpResp}
-		errorResponse.Errors = append(errorResponse.Errors, err)
-		return httpResp, errorResponse
+		return httpResp, newDecodeError(httpResp, body, err)
 	}
 
 	// err = json.Unmarshal(w.D.Results, responseBody)
@@ -305,8 +881,8 @@ func (c *Client) Do(req *http.Request, responseBody interface{}) (*http.Response
 // CheckResponse checks the Client response for errors, and returns them if
 // present. A response is considered an error if it has a status code outside
 // the 200 range. Client error responses are expected to have either no response
-// body, or a json response body that maps to ErrorResponse. Any other response
-// body will be silently ignored.
+// body, or a json response body that maps to ErrorResponse. The text of plain
+// text and HTML bodies becomes the message of the error.
 func CheckResponse(r *http.Response) error {
 	errorResponse := &ErrorResponse{Response: r}
 
@@ -320,16 +896,23 @@ func CheckResponse(r *http.Response) error {
 		return nil
 	}
 
-	err := checkContentType(r)
+	// read data and copy it back
+	data, err := ioutil.ReadAll(r.Body)
+	r.Body = ioutil.NopCloser(bytes.NewReader(data))
 	if err != nil {
-		errorResponse.Errors = append(errorResponse.Errors, errors.New(r.Status))
 		return errorResponse
 	}
 
-	// read data and copy it back
-	data, err := ioutil.ReadAll(r.Body)
-	r.Body = ioutil.NopCloser(bytes.NewReader(data))
+	// plain text or HTML pages, e.g. from IIS on signature failures
+	err = checkContentType(r)
 	if err != nil {
+		text := bodyText(r.Header.Get("Content-Type"), data)
+		if text == "" {
+			errorResponse.Errors = append(errorResponse.Errors, errors.New(r.Status))
+			return errorResponse
+		}
+
+		errorResponse.Errors = append(errorResponse.Errors, newTypedError(Error{Message: text}))
 		return errorResponse
 	}
 
@@ -347,6 +930,87 @@ func CheckResponse(r *http.Response) error {
 	return errorResponse
 }
 
+// EndpointError adds the name of the endpoint to errors returned by NewRequest
+// and Do
+type EndpointError struct {
+	Endpoint string
+	// RequestID is the ID sent with the request, empty when it wasn't sent
+	RequestID string
+	Err       error
+}
+
+func (e *EndpointError) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("%s: %s (request %s)", e.Endpoint, e.Err, e.RequestID)
+	}
+	return fmt.Sprintf("%s: %s", e.Endpoint, e.Err)
+}
+
+func (e *EndpointError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeError is returned when the response body can't be decoded. Do wraps
+// it in an EndpointError so the endpoint is part of the message.
+type DecodeError struct {
+	// HTTP response that caused this error
+	Response *http.Response
+	// Byte offset in the body where decoding failed, -1 when unknown
+	Offset int64
+	// Part of the raw body around Offset
+	Excerpt string
+	Err     error
+}
+
+// excerptSize is the number of bytes shown on each side of the offset
+const excerptSize = 40
+
+func newDecodeError(r *http.Response, body []byte, err error) *DecodeError {
+	offset := int64(-1)
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &syntaxErr) {
+		offset = syntaxErr.Offset
+	} else if errors.As(err, &typeErr) {
+		offset = typeErr.Offset
+	}
+
+	start, end := int64(0), int64(len(body))
+	if offset >= 0 {
+		if offset-excerptSize > start {
+			start = offset - excerptSize
+		}
+		if offset+excerptSize < end {
+			end = offset + excerptSize
+		}
+	} else if end > 2*excerptSize {
+		end = 2 * excerptSize
+	}
+
+	return &DecodeError{
+		Response: r,
+		Offset:   offset,
+		Excerpt:  string(body[start:end]),
+		Err:      err,
+	}
+}
+
+func (e *DecodeError) Error() string {
+	status := 0
+	if e.Response != nil {
+		status = e.Response.StatusCode
+	}
+
+	if e.Offset < 0 {
+		return fmt.Sprintf("decoding response (status %d): %s near %q", status, e.Err, e.Excerpt)
+	}
+	return fmt.Sprintf("decoding response (status %d) at offset %d: %s near %q", status, e.Offset, e.Err, e.Excerpt)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
 type ErrorResponse struct {
 	// HTTP response that caused this error
 	Response *http.Response `json:"-"`
@@ -360,6 +1024,9 @@ type Error struct {
 }
 
 func (e Error) Error() string {
+	if e.MessageDetail == "" {
+		return e.Message
+	}
 	return fmt.Sprintf("%s: %s", e.Message, e.MessageDetail)
 }
 
@@ -370,21 +1037,64 @@ func (r *ErrorResponse) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	r.Errors = append(r.Errors, e)
+	r.Errors = append(r.Errors, newTypedError(e))
 
 	return nil
 }
 
+// Error returns the method, endpoint path and status of the request followed
+// by the Merit messages, e.g. "POST /api/v1/sendinvoice: 400 Bad Request:
+// Periood on suletud"
 func (r ErrorResponse) Error() string {
-	if len(r.Errors) > 0 {
-		str := []string{}
-		for _, err := range r.Errors {
-			str = append(str, err.Error())
+	parts := []string{}
+	if r.Response != nil {
+		if req := r.Response.Request; req != nil && req.URL != nil {
+			parts = append(parts, req.Method+" "+req.URL.Path)
 		}
-		return strings.Join(str, ", ")
+
+		status := r.Response.Status
+		if status == "" {
+			status = fmt.Sprintf("%d %s", r.Response.StatusCode, http.StatusText(r.Response.StatusCode))
+		}
+		parts = append(parts, status)
+	}
+
+	messages := []string{}
+	for _, err := range r.Errors {
+		if err != nil && err.Error() != r.statusText() {
+			messages = append(messages, err.Error())
+		}
+	}
+	if len(messages) > 0 {
+		parts = append(parts, strings.Join(messages, ", "))
+	}
+
+	if len(parts) == 0 {
+		return "unknown error response"
+	}
+	return strings.Join(parts, ": ")
+}
+
+// statusText is the status line CheckResponse adds as error for bodies it
+// can't parse
+func (r ErrorResponse) statusText() string {
+	if r.Response == nil {
+		return ""
 	}
+	return r.Response.Status
+}
+
+// Unwrap returns the Merit errors of the response. errors.Is and errors.As
+// only use it since Go 1.20, the Is and As methods cover older versions.
+func (r ErrorResponse) Unwrap() []error {
+	return r.Errors
+}
 
-	return r.Errors[0].Error()
+// IsInvalidTimestampError reports whether err is Merit rejecting the request
+// because the signed timestamp is out of range, in any of the languages
+// ErrorCodeOf recognizes
+func IsInvalidTimestampError(err error) bool {
+	return ErrorCodeOf(err) == ErrorCodeInvalidTimestamp
 }
 
 func checkContentType(response *http.Response) error {
@@ -397,6 +1107,44 @@ func checkContentType(response *http.Response) error {
 	return nil
 }
 
+// maxBodyText is the maximum length of the text taken from a non JSON body
+const maxBodyText = 500
+
+var (
+	htmlTitleRegexp    = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+	htmlIgnoreRegexp   = regexp.MustCompile(`(?is)<(script|style|head)[^>]*>.*?</(script|style|head)>`)
+	htmlTagRegexp      = regexp.MustCompile(`(?s)<[^>]*>`)
+	whitespaceRegexp   = regexp.MustCompile(`\s+`)
+	htmlEntityReplacer = strings.NewReplacer("&amp;", "&", "&lt;", "<", "&gt;", ">", "&quot;", `"`, "&#39;", "'", "&nbsp;", " ")
+)
+
+// bodyText returns the readable text of a plain text or HTML error body: the
+// title and text of an HTML page, without markup
+func bodyText(contentType string, data []byte) string {
+	text := string(data)
+	if strings.Contains(contentType, "html") || htmlTagRegexp.MatchString(text) {
+		title := ""
+		if m := htmlTitleRegexp.FindStringSubmatch(text); m != nil {
+			title = strings.TrimSpace(m[1])
+		}
+
+		text = htmlIgnoreRegexp.ReplaceAllString(text, " ")
+		text = htmlTagRegexp.ReplaceAllString(text, " ")
+		text = whitespaceRegexp.ReplaceAllString(text, " ")
+		text = strings.TrimSpace(text)
+		if title != "" && !strings.HasPrefix(text, title) {
+			text = strings.TrimSpace(title + " " + text)
+		}
+		text = htmlEntityReplacer.Replace(text)
+	}
+
+	text = strings.TrimSpace(whitespaceRegexp.ReplaceAllString(text, " "))
+	if runes := []rune(text); len(runes) > maxBodyText {
+		text = string(runes[:maxBodyText]) + "..."
+	}
+	return text
+}
+
 type PathParams interface {
 	Params() map[string]string
 }
@@ -0,0 +1,1111 @@
+package aktiva_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestInvalidTimestampRetry(t *testing.T) {
+	for _, message := range []string{"Invalid timestamp", "Vigane ajatempel", "Virheellinen aikaleima"} {
+		calls := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Content-Type", "application/json")
+			if calls == 1 {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"Message": "` + message + `"}`))
+				return
+			}
+			w.Write([]byte(`[]`))
+		}))
+
+		baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+		c := aktiva.NewClient(nil, "id", "key")
+		c.SetBaseURL(*baseURL)
+
+		req := c.NewGetTaxesRequest()
+		_, err := req.Do(context.Background())
+		ts.Close()
+		if err != nil {
+			t.Fatalf("%s: %s", message, err)
+		}
+
+		if calls != 2 {
+			t.Errorf("%s: expected 2 calls, got %d", message, calls)
+		}
+
+		if c.TimestampRetries() != 1 {
+			t.Errorf("%s: expected 1 timestamp retry, got %d", message, c.TimestampRetries())
+		}
+	}
+}
+
+func TestCancelledContext(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req := c.NewGetTaxesRequest()
+	_, err := req.Do(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	endpointError := &aktiva.EndpointError{}
+	if !errors.As(err, &endpointError) || endpointError.Endpoint != "gettaxes" {
+		t.Errorf("expected error for endpoint gettaxes, got %v", err)
+	}
+
+	if calls != 0 {
+		t.Errorf("expected no calls, got %d", calls)
+	}
+}
+
+func TestCancelWhileReadingBody(t *testing.T) {
+	done := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"Code": "`))
+		w.(http.Flusher).Flush()
+		<-done
+	}))
+	defer ts.Close()
+	defer close(done)
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req := c.NewGetTaxesRequest()
+	_, err := req.Do(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestNilContext(t *testing.T) {
+	c := aktiva.NewClient(nil, "id", "key")
+	req := c.NewGetTaxesRequest()
+	_, err := req.Do(nil)
+	if err == nil {
+		t.Fatal("expected an error for a nil context")
+	}
+}
+
+func TestConcurrentRequestClones(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	req := c.NewGetGLBatchesRequest()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			clone := req.Clone()
+			clone.RequestBody().PeriodStart = aktiva.Date{Time: time.Date(2020, time.Month(i+1), 1, 0, 0, 0, 0, time.UTC)}
+			_, err := clone.Do(context.Background())
+			if err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if !req.RequestBody().PeriodStart.IsEmpty() {
+		t.Error("changes to clones should not affect the original request")
+	}
+}
+
+// run with -race: the shared request is only read while its Do calls run,
+// the goroutines changing it work on their own clone
+func TestConcurrentSharedRequest(t *testing.T) {
+	mu := sync.Mutex{}
+	received := map[string]int{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := struct {
+			PeriodStart string
+		}{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		mu.Lock()
+		received[body.PeriodStart]++
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	req := c.NewGetGLBatchesRequest()
+	req.RequestBody().PeriodStart = aktiva.Date{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := req.Do(context.Background())
+			if err != nil {
+				t.Error(err)
+			}
+		}()
+		go func(i int) {
+			defer wg.Done()
+			clone := req.Clone()
+			clone.RequestBody().PeriodStart = aktiva.Date{Time: time.Date(2021, time.Month(i+1), 1, 0, 0, 0, 0, time.UTC)}
+			_, err := clone.Do(context.Background())
+			if err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if received["20200101"] != 10 {
+		t.Errorf("expected the shared request 10 times, got %d", received["20200101"])
+	}
+	for i := 1; i <= 10; i++ {
+		start := fmt.Sprintf("2021%02d01", i)
+		if received[start] != 1 {
+			t.Errorf("expected clone %s once, got %d", start, received[start])
+		}
+	}
+}
+
+func TestFeatureUnavailable(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"Message": "Not available in your package"}`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	for i := 0; i < 2; i++ {
+		req := c.NewGetFixedAssetsRequest()
+		_, err := req.Do(context.Background())
+		if !errors.Is(err, aktiva.ErrFeatureUnavailable) {
+			t.Fatalf("expected ErrFeatureUnavailable, got %v", err)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+
+	if c.FeatureAvailable("getfixedassets") {
+		t.Error("expected getfixedassets to be unavailable")
+	}
+}
+
+func TestFeatureAvailableAfterNotFound(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		message string
+	}{
+		{"record not found", http.StatusNotFound, "Record not found"},
+		{"wrong base url", http.StatusNotFound, ""},
+		{"bad credentials", http.StatusForbidden, "ApiId not valid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				if tt.message != "" {
+					w.Write([]byte(`{"Message": "` + tt.message + `"}`))
+				}
+			}))
+			defer ts.Close()
+
+			baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+			c := aktiva.NewClient(nil, "id", "key")
+			c.SetBaseURL(*baseURL)
+
+			for i := 0; i < 3; i++ {
+				req := c.NewGetGLBatchRequest()
+				_, err := req.Do(context.Background())
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if errors.Is(err, aktiva.ErrFeatureUnavailable) {
+					t.Fatalf("expected the endpoint to stay available, got %v", err)
+				}
+			}
+
+			if calls != 3 {
+				t.Errorf("expected 3 calls, got %d", calls)
+			}
+			if !c.FeatureAvailable("getglbatch") {
+				t.Error("expected getglbatch to be available")
+			}
+		})
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"Code": 123}]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	req := c.NewGetTaxesRequest()
+	_, err := req.Do(context.Background())
+
+	decodeErr := &aktiva.DecodeError{}
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("expected a DecodeError, got %v", err)
+	}
+
+	if decodeErr.Response.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", decodeErr.Response.StatusCode)
+	}
+
+	if decodeErr.Offset <= 0 || decodeErr.Excerpt != `[{"Code": 123}]` {
+		t.Errorf("unexpected offset %d and excerpt %q", decodeErr.Offset, decodeErr.Excerpt)
+	}
+
+	if !strings.HasPrefix(err.Error(), "gettaxes: ") {
+		t.Errorf("expected the endpoint in %q", err.Error())
+	}
+}
+
+func TestAPIVersion(t *testing.T) {
+	c := aktiva.NewClient(nil, "id", "key")
+	if c.APIVersion() != aktiva.APIVersion1 {
+		t.Errorf("expected v1, got %s", c.APIVersion())
+	}
+
+	req := c.NewSendInvoiceV2Request()
+	u, _ := req.URL()
+	if u.Path != "/api/v2/sendinvoice" {
+		t.Errorf("expected the v2 endpoint, got %s", u.Path)
+	}
+
+	c.SetAPIVersion(aktiva.APIVersion2)
+	taxesReq := c.NewGetTaxesRequest()
+	u, _ = taxesReq.URL()
+	if u.Path != "/api/v2/gettaxes" {
+		t.Errorf("expected the v2 endpoint, got %s", u.Path)
+	}
+	if c.Endpoint(u) != "gettaxes" {
+		t.Errorf("expected gettaxes, got %s", c.Endpoint(u))
+	}
+}
+
+func TestNewClientForRegion(t *testing.T) {
+	c := aktiva.NewClientForRegion(nil, aktiva.RegionFI, "id", "key")
+	if c.BaseURL() != aktiva.BaseURLFI {
+		t.Errorf("expected the Finnish base URL, got %v", c.BaseURL())
+	}
+
+	c = aktiva.NewClientForRegion(nil, aktiva.Region("XX"), "id", "key")
+	if c.BaseURL() != aktiva.BaseURL {
+		t.Errorf("expected the default base URL, got %v", c.BaseURL())
+	}
+}
+
+type countingTransport struct {
+	calls int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestSetHTTPClientDoesNotMutate(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	transport := &countingTransport{}
+	httpClient := &http.Client{Transport: transport}
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(httpClient, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	if httpClient.Transport != transport {
+		t.Fatal("expected the transport of the passed client to be unchanged")
+	}
+
+	req := c.NewGetTaxesRequest()
+	_, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if transport.calls != 1 {
+		t.Errorf("expected the passed transport to be used, got %d calls", transport.calls)
+	}
+
+	other := &countingTransport{}
+	c.SetTransport(other)
+	_, err = req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if other.calls != 1 || transport.calls != 1 {
+		t.Errorf("expected the new transport to be used, got %d and %d calls", other.calls, transport.calls)
+	}
+}
+
+func TestAuthMode(t *testing.T) {
+	c := aktiva.NewClient(nil, "id", "key")
+	if c.AuthMode() != aktiva.AuthModeSignature {
+		t.Errorf("expected signature authentication by default, got %d", c.AuthMode())
+	}
+
+	c.SetAuthMode(aktiva.AuthModeNTLM)
+	if c.AuthMode() != aktiva.AuthModeNTLM {
+		t.Errorf("expected NTLM authentication, got %d", c.AuthMode())
+	}
+}
+
+func TestSetSigner(t *testing.T) {
+	var header string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("Authorization")
+		if r.URL.Query().Get("signature") != "" {
+			t.Error("expected no query signature")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+	c.SetSigner(aktiva.SignerFunc(func(req *http.Request, body *bytes.Buffer) error {
+		req.Header.Set("Authorization", "Bearer token")
+		return nil
+	}))
+
+	req := c.NewGetTaxesRequest()
+	_, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if header != "Bearer token" {
+		t.Errorf("expected the custom signer to be used, got %q", header)
+	}
+}
+
+type testPathParams map[string]string
+
+func (p testPathParams) Params() map[string]string {
+	return p
+}
+
+func TestGetEndpointURL(t *testing.T) {
+	c := aktiva.NewClient(nil, "id", "key")
+
+	u, err := c.GetEndpointURL("items/{{.code}}", testPathParams{"code": "A/B 1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.String() != "https://aktiva.merit.ee/api/v1/items/A%2FB%201" {
+		t.Errorf("expected an escaped path, got %s", u.String())
+	}
+
+	_, err = c.GetEndpointURL("items/{{.code}}", testPathParams{})
+	if err == nil {
+		t.Error("expected an error for a missing path parameter")
+	}
+}
+
+func TestConcurrentConfiguration(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			req := c.NewGetTaxesRequest()
+			_, err := req.Do(context.Background())
+			if err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			c.SetAPIKey("other")
+			c.SetDebug(false)
+			c.SetLocation(time.UTC)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestWithCredentials(t *testing.T) {
+	apiIDs := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiIDs = append(apiIDs, r.URL.Query().Get("ApiId"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "company-a", "key-a")
+	c.SetBaseURL(*baseURL)
+
+	other := c.WithCredentials("company-b", "key-b")
+	if c.APIID() != "company-a" {
+		t.Errorf("expected the original client to be unchanged, got %s", c.APIID())
+	}
+
+	for _, client := range []*aktiva.Client{c, other} {
+		req := client.NewGetTaxesRequest()
+		_, err := req.Do(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(apiIDs) != 2 || apiIDs[0] != "company-a" || apiIDs[1] != "company-b" {
+		t.Errorf("unexpected API IDs %v", apiIDs)
+	}
+}
+
+func TestNewRequestGetBody(t *testing.T) {
+	c := aktiva.NewClient(nil, "id", "key")
+	u, _ := c.GetEndpointURL("gettaxes", testPathParams{})
+
+	req, err := c.NewRequest(context.Background(), http.MethodPost, u, map[string]string{"Code": "VAT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// consume the body like the transport does
+	first, _ := ioutil.ReadAll(req.Body)
+
+	body, err := req.GetBody()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, _ := ioutil.ReadAll(body)
+
+	if string(first) != `{"Code":"VAT"}`+"\n" || string(first) != string(second) {
+		t.Errorf("expected GetBody to return the encoded body again, got %q and %q", first, second)
+	}
+}
+
+func TestDoWriter(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"Code": "VAT20"}]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	u, _ := c.GetEndpointURL("gettaxes", testPathParams{})
+	req, err := c.NewRequest(context.Background(), http.MethodGet, u, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := new(bytes.Buffer)
+	_, err = c.Do(req, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if buf.String() != `[{"Code": "VAT20"}]` {
+		t.Errorf("expected the raw body, got %q", buf.String())
+	}
+}
+
+func TestGzip(t *testing.T) {
+	var received []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			gz, err := gzip.NewReader(r.Body)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			received, _ = ioutil.ReadAll(gz)
+		}
+
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Errorf("expected Accept-Encoding gzip, got %q", r.Header.Get("Accept-Encoding"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		gz.Write([]byte(`[{"Code": "VAT20"}]`))
+		gz.Close()
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+	c.SetCompressRequests(true)
+
+	u, _ := c.GetEndpointURL("gettaxes", testPathParams{})
+	req, err := c.NewRequest(context.Background(), http.MethodPost, u, map[string]string{"Code": "VAT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := []struct{ Code string }{}
+	_, err = c.Do(req, &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(received) != `{"Code":"VAT"}`+"\n" {
+		t.Errorf("expected a gzipped request body, got %q", received)
+	}
+
+	if len(resp) != 1 || resp[0].Code != "VAT20" {
+		t.Errorf("expected the decompressed response, got %v", resp)
+	}
+}
+
+type testLogger struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+func (l *testLogger) Debug(msg string, args ...interface{}) { l.add("debug " + msg) }
+func (l *testLogger) Info(msg string, args ...interface{})  { l.add("info " + msg) }
+func (l *testLogger) Warn(msg string, args ...interface{})  { l.add("warn " + msg) }
+func (l *testLogger) Error(msg string, args ...interface{}) { l.add("error " + msg) }
+
+func (l *testLogger) add(msg string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.messages = append(l.messages, msg)
+}
+
+func TestLogger(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+	c.SetDebug(true)
+	logger := &testLogger{}
+	c.SetLogger(logger)
+
+	req := c.NewGetTaxesRequest()
+	_, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"debug request", "debug response"}
+	if strings.Join(logger.messages, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected %v, got %v", expected, logger.messages)
+	}
+}
+
+func TestStdLoggerLevel(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := aktiva.NewStdLogger(log.New(buf, "", 0), aktiva.LogLevelWarn)
+	logger.Debug("request", "dump", "GET /")
+	logger.Warn("retrying request", "endpoint", "gettaxes")
+
+	if buf.String() != "WARN retrying request endpoint=gettaxes\n" {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+}
+
+type testDumpLogger struct {
+	testLogger
+	dumps []string
+}
+
+func (l *testDumpLogger) Debug(msg string, args ...interface{}) {
+	l.dumps = append(l.dumps, args[3].(string))
+}
+
+func TestDebugRedaction(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "secret-id", "secret-key")
+	c.SetBaseURL(*baseURL)
+	c.SetDebug(true)
+	logger := &testDumpLogger{}
+	c.SetLogger(logger)
+
+	req := c.NewGetTaxesRequest()
+	_, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(logger.dumps) != 2 {
+		t.Fatalf("expected 2 dumps, got %d", len(logger.dumps))
+	}
+
+	dump := logger.dumps[0]
+	if strings.Contains(dump, "secret-id") || !strings.Contains(dump, "signature=REDACTED") {
+		t.Errorf("expected the credentials to be redacted, got %s", dump)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"Code": "` + r.Header.Get("X-Audit") + `"}]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	order := []string{}
+	c.Use(func(next aktiva.HandlerFunc) aktiva.HandlerFunc {
+		return func(req *http.Request, responseBody interface{}) (*http.Response, error) {
+			order = append(order, "outer")
+			req.Header.Set("X-Audit", "VAT20")
+			return next(req, responseBody)
+		}
+	}, func(next aktiva.HandlerFunc) aktiva.HandlerFunc {
+		return func(req *http.Request, responseBody interface{}) (*http.Response, error) {
+			order = append(order, "inner")
+			resp, err := next(req, responseBody)
+			if body, ok := responseBody.(*aktiva.GetTaxesResponseBody); ok && len(*body) == 1 {
+				order = append(order, (*body)[0].Code)
+			}
+			return resp, err
+		}
+	})
+
+	req := c.NewGetTaxesRequest()
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(order, ",") != "outer,inner,VAT20" || len(resp) != 1 {
+		t.Errorf("unexpected middleware calls %v", order)
+	}
+}
+
+func TestMetricsCollector(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"Message": "Too many requests"}`))
+			return
+		}
+		w.Write([]byte(`[{"Code": "VAT20"}]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	metrics := []aktiva.RequestMetrics{}
+	c.SetMetricsCollector(aktiva.MetricsCollectorFunc(func(m aktiva.RequestMetrics) {
+		metrics = append(metrics, m)
+	}))
+
+	u, _ := c.GetEndpointURL("gettaxes", testPathParams{})
+	req, err := c.NewRequest(context.Background(), http.MethodPost, u, map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := []struct{ Code string }{}
+	_, err = c.Do(req, &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(metrics) != 2 {
+		t.Fatalf("expected 2 attempts, got %d", len(metrics))
+	}
+
+	if !metrics[0].RateLimited || metrics[0].Err == nil || metrics[0].StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected the first attempt to be rate limited, got %+v", metrics[0])
+	}
+	if metrics[0].BytesReceived != int64(len(`{"Message": "Too many requests"}`)) {
+		t.Errorf("expected the error body to be counted, got %d", metrics[0].BytesReceived)
+	}
+
+	m := metrics[1]
+	if m.Endpoint != "gettaxes" || m.StatusCode != http.StatusOK || m.RateLimited || m.Err != nil {
+		t.Errorf("unexpected metrics %+v", m)
+	}
+	if m.BytesSent != 3 || m.BytesReceived != int64(len(`[{"Code": "VAT20"}]`)) {
+		t.Errorf("unexpected byte counts %d and %d", m.BytesSent, m.BytesReceived)
+	}
+}
+
+func TestAttemptHook(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"Message": "Invalid timestamp"}`))
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	attempts := []string{}
+	c.SetAttemptHook(func(req *http.Request, attempt int) (*http.Request, func(*http.Response, error)) {
+		if aktiva.Attempt(req.Context()) != attempt {
+			t.Errorf("expected attempt %d in the context, got %d", attempt, aktiva.Attempt(req.Context()))
+		}
+		return req, func(resp *http.Response, err error) {
+			attempts = append(attempts, strconv.Itoa(attempt)+":"+strconv.Itoa(resp.StatusCode))
+		}
+	})
+
+	req := c.NewGetTaxesRequest()
+	_, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(attempts, ",") != "1:400,2:200" {
+		t.Errorf("unexpected attempts %v", attempts)
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	ids := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids = append(ids, r.Header.Get(aktiva.RequestIDHeader))
+		w.Header().Set("Content-Type", "application/json")
+		if len(ids) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"Message": "Too many requests"}`))
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"Message": "Invalid tax code"}`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	req := c.NewGetTaxesRequest()
+	_, err := req.Do(context.Background(), aktiva.WithRequestID("posting-42"))
+
+	if len(ids) != 2 || ids[0] != "posting-42" || ids[1] != "posting-42" {
+		t.Errorf("expected the request ID on every attempt, got %v", ids)
+	}
+
+	endpointErr := &aktiva.EndpointError{}
+	if !errors.As(err, &endpointErr) || endpointErr.RequestID != "posting-42" {
+		t.Errorf("expected the request ID in the error, got %v", err)
+	}
+
+	u, _ := c.GetEndpointURL("gettaxes", testPathParams{})
+	r1, _ := c.NewRequest(context.Background(), http.MethodGet, u, nil)
+	r2, _ := c.NewRequest(context.Background(), http.MethodGet, u, nil)
+	if aktiva.RequestID(r1) == "" || aktiva.RequestID(r1) == aktiva.RequestID(r2) {
+		t.Errorf("expected unique generated request IDs, got %q and %q", aktiva.RequestID(r1), aktiva.RequestID(r2))
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	sent := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, path.Base(r.URL.Path))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	previewed := []string{}
+	c.SetDryRun(true, func(req *http.Request, body []byte) error {
+		if req.URL.Query().Get("signature") == "" {
+			t.Error("expected a signed request")
+		}
+		previewed = append(previewed, string(body))
+		return nil
+	})
+
+	taxes := c.NewGetTaxesRequest()
+	_, err := taxes.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	project := c.NewSendProjectRequest()
+	project.RequestBody().Code = "P1"
+	resp, err := project.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(sent, ",") != "gettaxes" {
+		t.Errorf("expected only gettaxes to be sent, got %v", sent)
+	}
+	if len(previewed) != 1 || !strings.Contains(previewed[0], `"Code":"P1"`) {
+		t.Errorf("expected the project in the dry run, got %v", previewed)
+	}
+	if resp.ID != (uuid.UUID{}) {
+		t.Errorf("expected an empty response, got %v", resp)
+	}
+}
+
+func TestTransportOptions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+	c.SetTransportOptions(aktiva.TransportOptions{ResponseHeaderTimeout: 10 * time.Millisecond})
+
+	req := c.NewGetTaxesRequest()
+	_, err := req.Do(context.Background())
+	if err == nil {
+		t.Fatal("expected the response header timeout to fail the request")
+	}
+
+	c.SetTransportOptions(aktiva.TransportOptions{})
+	c.SetTimeout(10 * time.Millisecond)
+	if c.Timeout() != 10*time.Millisecond {
+		t.Errorf("expected a timeout of 10ms, got %s", c.Timeout())
+	}
+
+	_, err = req.Do(context.Background())
+	if err == nil {
+		t.Fatal("expected the client timeout to fail the request")
+	}
+}
+
+func TestProxyAndTLSConfig(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	req := c.NewGetTaxesRequest()
+	_, err := req.Do(context.Background())
+	if err == nil {
+		t.Fatal("expected the unknown certificate to be rejected")
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ts.Certificate())
+	c.SetTLSConfig(&tls.Config{RootCAs: pool})
+	_, err = req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proxied := []string{}
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.Host)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer proxy.Close()
+
+	proxyURL, _ := url.Parse(proxy.URL)
+	c.SetBaseURL(url.URL{Scheme: "http", Host: "merit.invalid", Path: "/api/v1/"})
+	c.SetProxy(proxyURL)
+	_, err = req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(proxied) != 1 || proxied[0] != "merit.invalid" {
+		t.Errorf("expected the request to go through the proxy, got %v", proxied)
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	verified := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		q := r.URL.Query()
+		if aktiva.VerifySignature(q.Get("ApiId"), "key", q.Get("timestamp"), body, q.Get("signature")) {
+			verified++
+		}
+		if aktiva.VerifySignature(q.Get("ApiId"), "other", q.Get("timestamp"), body, q.Get("signature")) {
+			t.Error("expected the signature not to match another key")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	req := c.NewSendProjectRequest()
+	req.RequestBody().Name = "Fish & chips"
+	_, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if verified != 1 {
+		t.Error("expected the signature of the client to verify")
+	}
+}
+
+func TestClockSkew(t *testing.T) {
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetClock(func() time.Time { return time.Now().Add(-10 * time.Minute) })
+
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+
+		timestamp, _ := time.ParseInLocation(aktiva.TimestampLayout, r.URL.Query().Get("timestamp"), c.Location())
+		if d := time.Since(timestamp); d > time.Minute || d < -time.Minute {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"Message": "Invalid timestamp"}`))
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c.SetBaseURL(*baseURL)
+	c.SetLogger(nil)
+
+	req := c.NewGetTaxesRequest()
+	_, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+
+	if skew := c.ClockSkew(); skew < 9*time.Minute || skew > 11*time.Minute {
+		t.Errorf("expected a skew of about 10 minutes, got %s", skew)
+	}
+
+	// later requests use the corrected clock right away
+	_, err = req.Do(context.Background())
+	if err != nil || calls != 3 {
+		t.Errorf("expected the next request to succeed at once, got %v after %d calls", err, calls)
+	}
+}
@@ -0,0 +1,46 @@
+package aktiva
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// ClockSkew returns how far the clock of Merit is ahead of the local clock,
+// negative when it's behind. It's measured from the Date header when Merit
+// rejects a timestamp, and added to the timestamps of all later requests.
+func (c *Client) ClockSkew() time.Duration {
+	return time.Duration(atomic.LoadInt64(&c.clockSkew))
+}
+
+// SetClockSkew sets the correction of the timestamps, e.g. to restore a skew
+// measured by an earlier process
+func (c *Client) SetClockSkew(skew time.Duration) {
+	atomic.StoreInt64(&c.clockSkew, int64(skew))
+}
+
+// measureClockSkew updates the clock skew from the Date header of the
+// response of err. The header has a resolution of a second, so smaller
+// differences are ignored.
+func (c *Client) measureClockSkew(err error) {
+	errorResponse := &ErrorResponse{}
+	if !errors.As(err, &errorResponse) || errorResponse.Response == nil {
+		return
+	}
+
+	date, perr := http.ParseTime(errorResponse.Response.Header.Get("Date"))
+	if perr != nil {
+		return
+	}
+
+	skew := date.Sub(c.now()).Round(time.Second)
+	if skew > -time.Second && skew < time.Second {
+		skew = 0
+	}
+
+	if skew != c.ClockSkew() {
+		c.Logger().Warn("clock skew", "skew", skew)
+	}
+	c.SetClockSkew(skew)
+}
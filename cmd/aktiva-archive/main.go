@@ -0,0 +1,107 @@
+// Command aktiva-archive exports the GL batches and the sales and purchase
+// invoices, with their attached files, of the Merit Aktiva company of the
+// API_ID/API_KEY credentials to a directory and verifies such archives.
+// When ARCHIVE_KEY holds a hex encoded 32 byte key the archived files are
+// encrypted with it, verifying samples of such an archive needs the same key.
+//
+//	aktiva-archive export -dir archive -start 2019-01-01 -end 2019-12-31
+//	aktiva-archive verify -dir archive -samples 10
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+	"github.com/omniboost/go-merit-aktiva/archive"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: aktiva-archive export|verify [flags]")
+		os.Exit(2)
+	}
+
+	client := aktiva.NewClient(nil, os.Getenv("API_ID"), os.Getenv("API_KEY"))
+	if os.Getenv("DEBUG") != "" {
+		client.SetDebug(true)
+	}
+	batches := archive.GLBatchSource{Client: client}
+	invoices := archive.InvoiceSource{Client: client}
+	purchaseInvoices := archive.PurchaseInvoiceSource{Client: client}
+
+	var key []byte
+	if os.Getenv("ARCHIVE_KEY") != "" {
+		var err error
+		key, err = archive.ParseKey(os.Getenv("ARCHIVE_KEY"))
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	flags := flag.NewFlagSet(os.Args[1], flag.ExitOnError)
+	dir := flags.String("dir", "archive", "archive directory")
+
+	switch os.Args[1] {
+	case "export":
+		start := flags.String("start", "", "first document date (yyyy-mm-dd)")
+		end := flags.String("end", "", "last document date (yyyy-mm-dd)")
+		flags.Parse(os.Args[2:])
+
+		startDate, err := time.Parse("2006-01-02", *start)
+		if err != nil {
+			log.Fatal(err)
+		}
+		endDate, err := time.Parse("2006-01-02", *end)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		exporter := archive.NewExporter(*dir, batches, invoices, purchaseInvoices)
+		exporter.Key = key
+		indexes, err := exporter.Export(context.Background(), startDate, endDate)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, index := range indexes {
+			fmt.Printf("%d: %d files\n", index.FiscalYear, len(index.Entries))
+		}
+	case "verify":
+		samples := flags.Int("samples", 0, "number of documents per year to fetch again from the API")
+		seed := flags.Int64("seed", time.Now().UnixNano(), "seed for selecting samples, printed in the report")
+		flags.Parse(os.Args[2:])
+
+		verifier := archive.NewVerifier(*dir)
+		verifier.SampleSize = *samples
+		verifier.Seed = *seed
+		verifier.Key = key
+		if *samples > 0 {
+			verifier.Fetcher = archive.Fetchers{
+				"glbatch":      batches,
+				"invoice":      invoices,
+				"purchinvoice": purchaseInvoices,
+			}
+		}
+
+		report, err := verifier.Verify(context.Background())
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		enc.Encode(report)
+		if !report.OK() {
+			fmt.Fprintf(os.Stderr, "verification failed, check the same samples again with -seed %d\n", report.Seed)
+			os.Exit(1)
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command \"%s\"\n", os.Args[1])
+		os.Exit(2)
+	}
+}
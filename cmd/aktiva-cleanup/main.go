@@ -0,0 +1,153 @@
+// Command aktiva-cleanup deletes what an example or end-to-end test run
+// created in a Merit Aktiva demo company, so the next run starts from a clean
+// book. Runs record the invoices and GL batches they create in a run file, see
+// examples/invoice-flow; only the entities in that file are deleted.
+//
+// Before deleting anything every recorded invoice and GL batch is fetched with
+// the API_ID/API_KEY credentials and compared with the number it was created
+// with. When one is missing or different the credentials belong to another
+// company and nothing is deleted. Items and customers are listed but kept:
+// the examples reuse them and Merit's API can't delete them.
+//
+// Without -delete the command only lists the recorded entities. Deleting
+// refuses to run unless the DANGEROUS_CONFIRM environment variable equals the
+// ID of the run. Deleted entities are removed from the run file, the file
+// itself once nothing deletable is left.
+//
+//	aktiva-cleanup -run run-20201014120000.json
+//	DANGEROUS_CONFIRM=20201014120000 aktiva-cleanup -run run-20201014120000.json -delete
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/gofrs/uuid"
+	aktiva "github.com/omniboost/go-merit-aktiva"
+	"github.com/omniboost/go-merit-aktiva/internal/testrun"
+)
+
+func main() {
+	path := flag.String("run", "", "run file written by the example or test run")
+	del := flag.Bool("delete", false, "delete the recorded entities instead of listing them")
+	flag.Parse()
+
+	if *path == "" {
+		log.Fatal("-run is required")
+	}
+
+	run, err := testrun.Load(*path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *del && os.Getenv("DANGEROUS_CONFIRM") != run.ID {
+		log.Fatal("refusing to delete: set DANGEROUS_CONFIRM to the ID of the run")
+	}
+
+	client := aktiva.NewClient(nil, os.Getenv("API_ID"), os.Getenv("API_KEY"))
+	if os.Getenv("DEBUG") != "" {
+		client.SetDebug(true)
+	}
+
+	// check all entities first, so a wrong company deletes nothing
+	ctx := context.Background()
+	for _, e := range run.Created {
+		err := check(ctx, client, e)
+		if err != nil {
+			log.Fatalf("refusing to delete: %s, the credentials don't belong to the company of run %s", err, run.ID)
+		}
+	}
+
+	kept := []testrun.Entity{}
+	deleted := 0
+	// delete in reverse order, later documents can depend on earlier ones
+	for i := len(run.Created) - 1; i >= 0; i-- {
+		e := run.Created[i]
+		if !deletable(e) {
+			fmt.Printf("keep   %-8s %s %s\n", e.Kind, e.Key, e.ID)
+			continue
+		}
+
+		fmt.Printf("delete %-8s %s %s\n", e.Kind, e.Key, e.ID)
+		if !*del {
+			continue
+		}
+
+		err := remove(ctx, client, e)
+		if err != nil {
+			log.Printf("deleting %s %s: %s", e.Kind, e.Key, err)
+			kept = append([]testrun.Entity{e}, kept...)
+			continue
+		}
+		deleted++
+	}
+
+	if !*del {
+		fmt.Printf("run %s: run with -delete to delete the entities marked delete\n", run.ID)
+		return
+	}
+
+	// keep what's left for a next attempt, drop the file once only items
+	// and customers remain
+	run.Created = kept
+	err = run.Save()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("deleted %d entities of run %s\n", deleted, run.ID)
+	if len(kept) > 0 {
+		os.Exit(1)
+	}
+}
+
+func deletable(e testrun.Entity) bool {
+	return e.Kind == testrun.KindInvoice || e.Kind == testrun.KindGLBatch
+}
+
+// check fetches a recorded entity and compares it with the recorded key
+func check(ctx context.Context, client *aktiva.Client, e testrun.Entity) error {
+	switch e.Kind {
+	case testrun.KindInvoice:
+		req := client.NewGetInvoiceRequest()
+		req.RequestBody().ID = uuid.FromStringOrNil(e.ID)
+		invoice, err := req.Do(ctx)
+		if err != nil {
+			return fmt.Errorf("fetching invoice %s: %w", e.Key, err)
+		}
+		if invoice.Header.InvoiceNo != e.Key {
+			return fmt.Errorf("invoice %s has number \"%s\"", e.ID, invoice.Header.InvoiceNo)
+		}
+	case testrun.KindGLBatch:
+		req := client.NewGetGLBatchRequest()
+		req.RequestBody().ID = uuid.FromStringOrNil(e.ID)
+		batch, err := req.Do(ctx)
+		if err != nil {
+			return fmt.Errorf("fetching GL batch %s: %w", e.Key, err)
+		}
+		no := batch.Header.BatchCode + strconv.Itoa(batch.Header.No)
+		if no != e.Key {
+			return fmt.Errorf("GL batch %s has number \"%s\"", e.ID, no)
+		}
+	}
+	return nil
+}
+
+func remove(ctx context.Context, client *aktiva.Client, e testrun.Entity) error {
+	switch e.Kind {
+	case testrun.KindInvoice:
+		req := client.NewDeleteInvoiceRequest()
+		req.RequestBody().ID = uuid.FromStringOrNil(e.ID)
+		_, err := req.Do(ctx)
+		return err
+	case testrun.KindGLBatch:
+		req := client.NewDeleteGLBatchRequest()
+		req.RequestBody().ID = uuid.FromStringOrNil(e.ID)
+		_, err := req.Do(ctx)
+		return err
+	}
+	return fmt.Errorf("can't delete a %s", e.Kind)
+}
@@ -0,0 +1,32 @@
+// Command aktiva-lint scans the Merit Aktiva company of the API_ID/API_KEY
+// credentials for data quality issues and prints the report as JSON.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+	"github.com/omniboost/go-merit-aktiva/lint"
+)
+
+func main() {
+	client := aktiva.NewClient(nil, os.Getenv("API_ID"), os.Getenv("API_KEY"))
+	if os.Getenv("DEBUG") != "" {
+		client.SetDebug(true)
+	}
+
+	report, err := lint.New(client).Run(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	err = enc.Encode(report)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,164 @@
+package aktiva
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+func datesEqual(a, b Date) bool {
+	return a.Time.Equal(b.Time)
+}
+
+// Equal compares all fields of two customers. Amounts are decimals, so they
+// are compared exactly.
+func (c Customer) Equal(other Customer) bool {
+	return reflect.DeepEqual(c, other)
+}
+
+// Equal compares all fields of two vendors
+func (v Vendor) Equal(other Vendor) bool {
+	return reflect.DeepEqual(v, other)
+}
+
+// Equal compares all fields of two items, treating empty and missing
+// translations the same
+func (i Item) Equal(other Item) bool {
+	a, b := i, other
+	a.Descriptions, b.Descriptions = nil, nil
+
+	if len(i.Descriptions) != 0 || len(other.Descriptions) != 0 {
+		if !reflect.DeepEqual(i.Descriptions, other.Descriptions) {
+			return false
+		}
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// Equal compares two GL transactions including their lines
+func (t GLTransaction) Equal(other GLTransaction) bool {
+	a, b := t, other
+	a.Lines, b.Lines = nil, nil
+
+	if !reflect.DeepEqual(a, b) {
+		return false
+	}
+
+	if len(t.Lines) != len(other.Lines) {
+		return false
+	}
+
+	for i := range t.Lines {
+		if !t.Lines[i].Equal(other.Lines[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal compares two GL transaction lines. The amounts are decimals, so they
+// are compared exactly.
+func (l GLTransactionLine) Equal(other GLTransactionLine) bool {
+	a, b := l, other
+	a.Dimensions, b.Dimensions = nil, nil
+
+	if len(l.Dimensions) != 0 || len(other.Dimensions) != 0 {
+		if !reflect.DeepEqual(l.Dimensions, other.Dimensions) {
+			return false
+		}
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// Equal compares two debt report rows
+func (r DebtReportRow) Equal(other DebtReportRow) bool {
+	return r.PartnerID == other.PartnerID &&
+		r.PartnerName == other.PartnerName &&
+		r.DocNo == other.DocNo &&
+		datesEqual(r.DocDate, other.DocDate) &&
+		datesEqual(r.DueDate, other.DueDate) &&
+		r.CurrencyCode == other.CurrencyCode &&
+		r.TotalAmount.Equal(other.TotalAmount) &&
+		r.PaidAmount.Equal(other.PaidAmount) &&
+		r.UnPaidAmount.Equal(other.UnPaidAmount)
+}
+
+// SortByName sorts the customers by name, then by id
+func (cc Customers) SortByName() {
+	sort.SliceStable(cc, func(i, j int) bool {
+		if !strings.EqualFold(cc[i].Name, cc[j].Name) {
+			return strings.ToLower(cc[i].Name) < strings.ToLower(cc[j].Name)
+		}
+		return cc[i].CustomerID < cc[j].CustomerID
+	})
+}
+
+// SortByName sorts the vendors by name, then by id
+func (vv Vendors) SortByName() {
+	sort.SliceStable(vv, func(i, j int) bool {
+		if !strings.EqualFold(vv[i].Name, vv[j].Name) {
+			return strings.ToLower(vv[i].Name) < strings.ToLower(vv[j].Name)
+		}
+		return vv[i].VendorID < vv[j].VendorID
+	})
+}
+
+// SortByCode sorts the items by item code, then by id
+func (ii Items) SortByCode() {
+	sort.SliceStable(ii, func(i, j int) bool {
+		if ii[i].Code != ii[j].Code {
+			return ii[i].Code < ii[j].Code
+		}
+		return ii[i].ItemID < ii[j].ItemID
+	})
+}
+
+// SortByDate sorts the transactions by batch date, then by number
+func (tt GLTransactions) SortByDate() {
+	sort.SliceStable(tt, func(i, j int) bool {
+		if tt[i].BatchDate != tt[j].BatchDate {
+			return tt[i].BatchDate < tt[j].BatchDate
+		}
+		return tt[i].less(tt[j])
+	})
+}
+
+// SortByNumber sorts the transactions by batch code and number
+func (tt GLTransactions) SortByNumber() {
+	sort.SliceStable(tt, func(i, j int) bool {
+		return tt[i].less(tt[j])
+	})
+}
+
+func (t GLTransaction) less(other GLTransaction) bool {
+	if t.BatchCode != other.BatchCode {
+		return t.BatchCode < other.BatchCode
+	}
+	if t.No != other.No {
+		return t.No < other.No
+	}
+	return t.GLBID < other.GLBID
+}
+
+// SortByDate sorts the rows by due date, then by document number
+func (rr DebtReportRows) SortByDate() {
+	sort.SliceStable(rr, func(i, j int) bool {
+		if !rr[i].DueDate.Equal(rr[j].DueDate.Time) {
+			return rr[i].DueDate.Before(rr[j].DueDate.Time)
+		}
+		return rr[i].DocNo < rr[j].DocNo
+	})
+}
+
+// SortByNumber sorts the rows by document number, then by partner
+func (rr DebtReportRows) SortByNumber() {
+	sort.SliceStable(rr, func(i, j int) bool {
+		if rr[i].DocNo != rr[j].DocNo {
+			return rr[i].DocNo < rr[j].DocNo
+		}
+		return rr[i].PartnerID < rr[j].PartnerID
+	})
+}
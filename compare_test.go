@@ -0,0 +1,60 @@
+package aktiva_test
+
+import (
+	"testing"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestGLTransactionEqual(t *testing.T) {
+	a := aktiva.GLTransaction{
+		GLBID: "1",
+		Lines: aktiva.GLTransactionLines{
+			{AccountCode: "1000", DebitAmount: aktiva.MustParseDecimal("0.1").Add(aktiva.MustParseDecimal("0.2"))},
+		},
+	}
+	b := aktiva.GLTransaction{
+		GLBID: "1",
+		Lines: aktiva.GLTransactionLines{
+			{AccountCode: "1000", DebitAmount: aktiva.MustParseDecimal("0.3"), Dimensions: aktiva.RowDimensions{}},
+		},
+	}
+
+	if !a.Equal(b) {
+		t.Error("expected transactions to be equal")
+	}
+
+	b.Lines[0].DebitAmount = aktiva.MustParseDecimal("0.31")
+	if a.Equal(b) {
+		t.Error("expected transactions to differ")
+	}
+}
+
+func TestGLTransactionsSortByNumber(t *testing.T) {
+	tt := aktiva.GLTransactions{
+		{GLBID: "3", BatchCode: "PI", No: 1},
+		{GLBID: "2", BatchCode: "GL", No: 10},
+		{GLBID: "1", BatchCode: "GL", No: 2},
+	}
+	tt.SortByNumber()
+
+	for i, id := range []string{"1", "2", "3"} {
+		if tt[i].GLBID != id {
+			t.Errorf("position %d: expected %s, got %s", i, id, tt[i].GLBID)
+		}
+	}
+}
+
+func TestItemEqual(t *testing.T) {
+	a := aktiva.Item{ItemID: "1", SalesPrice: aktiva.MustParseDecimal("0.1").Add(aktiva.MustParseDecimal("0.2"))}
+	b := aktiva.Item{ItemID: "1", SalesPrice: aktiva.MustParseDecimal("0.3")}
+
+	if !a.Equal(b) {
+		t.Error("expected items to be equal")
+	}
+
+	b.SalesPrice = aktiva.MustParseDecimal("0.301")
+	if a.Equal(b) {
+		t.Error("expected items to differ")
+	}
+}
@@ -0,0 +1,104 @@
+package aktiva
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MaxPeriodMonths is the longest period, in months, Merit accepts in the
+// PeriodStart/PeriodEnd filter of list requests
+const MaxPeriodMonths = 3
+
+// DateWindow is a period of whole days, both Start and End are included
+type DateWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// SplitDateRange splits the days from start to end (inclusive) into
+// consecutive windows of at most months months. It returns no windows when end
+// is before start.
+func SplitDateRange(start, end time.Time, months int) []DateWindow {
+	if months < 1 {
+		months = 1
+	}
+
+	windows := []DateWindow{}
+	for !end.Before(start) {
+		next := start.AddDate(0, months, 0)
+		w := DateWindow{Start: start, End: next.AddDate(0, 0, -1)}
+		if w.End.After(end) {
+			w.End = end
+		}
+
+		windows = append(windows, w)
+		start = next
+	}
+
+	return windows
+}
+
+// ForEachDateWindow calls fn for every window, with at most concurrency calls
+// running at the same time. The index of the window is passed along, so
+// results can be stored in a slice of len(windows) and merged in order:
+//
+//	windows := aktiva.SplitDateRange(start, end, aktiva.MaxPeriodMonths)
+//	results := make([]aktiva.GetGLBatchesResponseBody, len(windows))
+//	err := aktiva.ForEachDateWindow(ctx, windows, 2, func(ctx context.Context, i int, w aktiva.DateWindow) error {
+//		req := client.NewGetGLBatchesRequest()
+//		req.RequestBody().PeriodStart = aktiva.Date{Time: w.Start}
+//		req.RequestBody().PeriodEnd = aktiva.Date{Time: w.End}
+//		resp, err := req.Do(ctx)
+//		results[i] = resp
+//		return err
+//	})
+//
+// The first error cancels the context passed to the other calls and is
+// returned.
+func ForEachDateWindow(ctx context.Context, windows []DateWindow, concurrency int, fn func(ctx context.Context, i int, w DateWindow) error) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+
+	sem := make(chan struct{}, concurrency)
+	for i, w := range windows {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, w DateWindow) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := fn(ctx, i, w)
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(i, w)
+	}
+
+	wg.Wait()
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,60 @@
+package aktiva_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestSplitDateRange(t *testing.T) {
+	start := time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	windows := aktiva.SplitDateRange(start, end, 3)
+	expected := []string{"20200115-20200414", "20200415-20200714", "20200715-20200801"}
+
+	if len(windows) != len(expected) {
+		t.Fatalf("expected %d windows, got %d", len(expected), len(windows))
+	}
+
+	for i, w := range windows {
+		s := w.Start.Format("20060102") + "-" + w.End.Format("20060102")
+		if s != expected[i] {
+			t.Errorf("window %d: expected %s, got %s", i, expected[i], s)
+		}
+	}
+}
+
+func TestForEachDateWindow(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	windows := aktiva.SplitDateRange(start, start.AddDate(1, 0, -1), 1)
+
+	results := make([]int, len(windows))
+	err := aktiva.ForEachDateWindow(context.Background(), windows, 4, func(ctx context.Context, i int, w aktiva.DateWindow) error {
+		results[i] = int(w.Start.Month())
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, m := range results {
+		if m != i+1 {
+			t.Errorf("window %d: expected month %d, got %d", i, i+1, m)
+		}
+	}
+
+	failure := errors.New("failure")
+	err = aktiva.ForEachDateWindow(context.Background(), windows, 1, func(ctx context.Context, i int, w aktiva.DateWindow) error {
+		if i == 2 {
+			return failure
+		}
+		return nil
+	})
+	if err != failure {
+		t.Errorf("expected the error of the failed window, got %v", err)
+	}
+}
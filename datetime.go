@@ -14,7 +14,7 @@ func (d *DateTime) MarshalJSON() ([]byte, error) {
 		return json.Marshal(nil)
 	}
 
-	return json.Marshal(d.Time.Format("20060102150405"))
+	return json.Marshal(d.Time.Format(DateTimeLayout))
 }
 
 func (d DateTime) IsEmpty() bool {
@@ -38,10 +38,10 @@ func (d *DateTime) UnmarshalJSON(text []byte) (err error) {
 		return nil
 	}
 
-	d.Time, err = time.Parse("20060102150405", value)
+	d.Time, err = time.Parse(DateTimeLayout, value)
 	return
 }
 
 func (d DateTime) String() string {
-	return d.Time.Format("20060102150405")
+	return d.Time.Format(DateTimeLayout)
 }
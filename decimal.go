@@ -0,0 +1,296 @@
+package aktiva
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// DecimalPlaces is the precision of a Decimal
+const DecimalPlaces = 8
+
+// decimalUnit is the stored value of 1
+const decimalUnit = 100000000
+
+// Decimal is a fixed point number with DecimalPlaces decimals, used for
+// amounts, prices, quantities and rates so totals add up exactly. It holds
+// values up to about ±92 billion. The zero value is 0.
+//
+// In JSON it's a number with the trailing zeros removed: 12.5, not
+// 12.50000000. Use Round(2) for amounts before sending them, Merit rejects
+// totals that don't match the rounded row totals.
+type Decimal struct {
+	v int64
+}
+
+var (
+	errDecimalSyntax = errors.New("invalid decimal")
+	errDecimalRange  = errors.New("decimal out of range")
+)
+
+// NewDecimal returns value * 10^-places, e.g. NewDecimal(1999, 2) is 19.99.
+// Digits beyond DecimalPlaces are rounded half away from zero. It panics when
+// the value is out of range.
+func NewDecimal(value int64, places int32) Decimal {
+	return decimalOf(scale(big.NewInt(value), places))
+}
+
+// NewDecimalFromFloat converts f, rounded to DecimalPlaces decimals. It
+// panics when f is out of range or not a number.
+func NewDecimalFromFloat(f float64) Decimal {
+	d, err := ParseDecimal(strconv.FormatFloat(f, 'f', DecimalPlaces, 64))
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// ParseDecimal parses a decimal number like "-1234.5678". Exponents aren't
+// supported.
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+
+	digits, places := s, int32(0)
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		digits = s[:i] + s[i+1:]
+		places = int32(len(s) - i - 1)
+		if places == 0 {
+			return Decimal{}, fmt.Errorf("%w %q", errDecimalSyntax, s)
+		}
+	}
+
+	value, ok := new(big.Int).SetString(digits, 10)
+	if !ok || strings.HasPrefix(digits, "+") {
+		return Decimal{}, fmt.Errorf("%w %q", errDecimalSyntax, s)
+	}
+
+	scaled := scale(value, places)
+	if !scaled.IsInt64() {
+		return Decimal{}, fmt.Errorf("%w %q", errDecimalRange, s)
+	}
+	return Decimal{v: scaled.Int64()}, nil
+}
+
+// MustParseDecimal is ParseDecimal for constants, it panics on invalid input
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// decimalOf returns the decimal with the stored value v, it panics when v
+// doesn't fit
+func decimalOf(v *big.Int) Decimal {
+	if !v.IsInt64() {
+		panic(errDecimalRange)
+	}
+	return Decimal{v: v.Int64()}
+}
+
+// scale converts value * 10^-places to the stored precision
+func scale(value *big.Int, places int32) *big.Int {
+	if places <= DecimalPlaces {
+		return new(big.Int).Mul(value, pow10(DecimalPlaces-places))
+	}
+	return divRound(value, pow10(places-DecimalPlaces))
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// divRound divides a by b, rounding half away from zero
+func divRound(a, b *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(a, b, new(big.Int))
+	r.Abs(r).Mul(r, big.NewInt(2))
+	if r.Cmp(new(big.Int).Abs(b)) >= 0 {
+		if a.Sign()*b.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// Add returns d + other. Like the other arithmetic methods it panics when the
+// result is out of range instead of wrapping around.
+func (d Decimal) Add(other Decimal) Decimal {
+	v := d.v + other.v
+	if (v > d.v) != (other.v > 0) {
+		panic(errDecimalRange)
+	}
+	return Decimal{v: v}
+}
+
+func (d Decimal) Sub(other Decimal) Decimal {
+	v := d.v - other.v
+	if (v < d.v) != (other.v > 0) {
+		panic(errDecimalRange)
+	}
+	return Decimal{v: v}
+}
+
+// Mul returns d * other rounded to DecimalPlaces decimals
+func (d Decimal) Mul(other Decimal) Decimal {
+	p := new(big.Int).Mul(big.NewInt(d.v), big.NewInt(other.v))
+	return decimalOf(divRound(p, big.NewInt(decimalUnit)))
+}
+
+// Div returns d / other rounded to DecimalPlaces decimals. It panics when
+// other is zero.
+func (d Decimal) Div(other Decimal) Decimal {
+	if other.v == 0 {
+		panic("aktiva: decimal division by zero")
+	}
+	p := new(big.Int).Mul(big.NewInt(d.v), big.NewInt(decimalUnit))
+	return decimalOf(divRound(p, big.NewInt(other.v)))
+}
+
+func (d Decimal) Neg() Decimal {
+	if d.v == math.MinInt64 {
+		panic(errDecimalRange)
+	}
+	return Decimal{v: -d.v}
+}
+
+func (d Decimal) Abs() Decimal {
+	if d.v < 0 {
+		return d.Neg()
+	}
+	return d
+}
+
+// Round rounds d to places decimals, half away from zero
+func (d Decimal) Round(places int32) Decimal {
+	if places >= DecimalPlaces {
+		return d
+	}
+	if places < 0 {
+		places = 0
+	}
+
+	unit := pow10(DecimalPlaces - places)
+	q := divRound(big.NewInt(d.v), unit)
+	return decimalOf(q.Mul(q, unit))
+}
+
+// Sign returns -1, 0 or 1
+func (d Decimal) Sign() int {
+	switch {
+	case d.v < 0:
+		return -1
+	case d.v > 0:
+		return 1
+	}
+	return 0
+}
+
+func (d Decimal) IsZero() bool {
+	return d.v == 0
+}
+
+// Cmp returns -1, 0 or 1 when d is less than, equal to or greater than other
+func (d Decimal) Cmp(other Decimal) int {
+	switch {
+	case d.v < other.v:
+		return -1
+	case d.v > other.v:
+		return 1
+	}
+	return 0
+}
+
+func (d Decimal) Equal(other Decimal) bool {
+	return d.v == other.v
+}
+
+// Float64 returns the nearest float, for display and legacy code
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// String returns the number without trailing zeros, e.g. "-12.5"
+func (d Decimal) String() string {
+	sign := ""
+	v := d.v
+	if v < 0 {
+		sign = "-"
+	}
+
+	abs := new(big.Int).Abs(big.NewInt(v)).String()
+	for len(abs) <= DecimalPlaces {
+		abs = "0" + abs
+	}
+
+	units, fraction := abs[:len(abs)-DecimalPlaces], strings.TrimRight(abs[len(abs)-DecimalPlaces:], "0")
+	if fraction == "" {
+		return sign + units
+	}
+	return sign + units + "." + fraction
+}
+
+// StringFixed returns the number with exactly places decimals
+func (d Decimal) StringFixed(places int32) string {
+	s := d.Round(places).String()
+	if places <= 0 {
+		return s
+	}
+
+	i := strings.IndexByte(s, '.')
+	if i < 0 {
+		return s + "." + strings.Repeat("0", int(places))
+	}
+	return s + strings.Repeat("0", int(places)-(len(s)-i-1))
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON accepts numbers, numeric strings and null (zero)
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		*d = Decimal{}
+		return nil
+	}
+
+	s := string(bytes.Trim(data, `"`))
+	if s == "" {
+		*d = Decimal{}
+		return nil
+	}
+
+	// exponents, as some JSON encoders write large floats
+	if strings.ContainsAny(s, "eE") {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		s = strconv.FormatFloat(f, 'f', DecimalPlaces, 64)
+	}
+
+	v, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// SumDecimals returns the sum of values
+func SumDecimals(values ...Decimal) Decimal {
+	sum := Decimal{}
+	for _, v := range values {
+		sum = sum.Add(v)
+	}
+	return sum
+}
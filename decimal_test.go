@@ -0,0 +1,122 @@
+package aktiva_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{"0", "0"},
+		{"12.50", "12.5"},
+		{"-0.1", "-0.1"},
+		{".5", "0.5"},
+		{"1234567.12345678", "1234567.12345678"},
+		{"0.123456785", "0.12345679"},
+	}
+
+	for _, tt := range tests {
+		d, err := aktiva.ParseDecimal(tt.in)
+		if err != nil {
+			t.Errorf("%s: %s", tt.in, err)
+			continue
+		}
+		if d.String() != tt.expected {
+			t.Errorf("%s: expected %s, got %s", tt.in, tt.expected, d)
+		}
+	}
+
+	for _, in := range []string{"", "abc", "1.", "+1", "1e3", "99999999999999"} {
+		if _, err := aktiva.ParseDecimal(in); err == nil {
+			t.Errorf("%q: expected error", in)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	sum := aktiva.SumDecimals(aktiva.MustParseDecimal("0.1"), aktiva.MustParseDecimal("0.2"))
+	if !sum.Equal(aktiva.MustParseDecimal("0.3")) {
+		t.Errorf("expected 0.3, got %s", sum)
+	}
+
+	vat := aktiva.MustParseDecimal("19.99").Mul(aktiva.NewDecimal(3, 0)).Mul(aktiva.MustParseDecimal("0.22"))
+	if vat.String() != "13.1934" {
+		t.Errorf("expected 13.1934, got %s", vat)
+	}
+	if vat.StringFixed(2) != "13.19" {
+		t.Errorf("expected 13.19, got %s", vat.StringFixed(2))
+	}
+
+	third := aktiva.NewDecimal(100, 0).Div(aktiva.NewDecimal(3, 0))
+	if third.String() != "33.33333333" {
+		t.Errorf("expected 33.33333333, got %s", third)
+	}
+
+	rounded := aktiva.MustParseDecimal("-2.345").Round(2)
+	if rounded.String() != "-2.35" {
+		t.Errorf("expected -2.35, got %s", rounded)
+	}
+
+	if aktiva.NewDecimal(5, 0).StringFixed(2) != "5.00" {
+		t.Errorf("expected 5.00, got %s", aktiva.NewDecimal(5, 0).StringFixed(2))
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	row := struct {
+		Price    aktiva.Decimal
+		Quantity aktiva.Decimal
+		Rate     *aktiva.Decimal `json:",omitempty"`
+	}{
+		Price:    aktiva.MustParseDecimal("1000.10"),
+		Quantity: aktiva.NewDecimal(2, 0),
+	}
+
+	b, err := json.Marshal(row)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"Price":1000.1,"Quantity":2}` {
+		t.Errorf("unexpected json: %s", b)
+	}
+
+	err = json.Unmarshal([]byte(`{"Price":"12.34","Quantity":1.5e2,"Rate":null}`), &row)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if row.Price.String() != "12.34" || row.Quantity.String() != "150" || row.Rate != nil {
+		t.Errorf("unexpected values: %s %s %v", row.Price, row.Quantity, row.Rate)
+	}
+}
+
+func TestDecimalOverflow(t *testing.T) {
+	max := aktiva.MustParseDecimal("92233720368.54775807")
+
+	tests := map[string]func(){
+		"add": func() { max.Add(aktiva.NewDecimal(1, 0)) },
+		"sub": func() { max.Neg().Sub(aktiva.NewDecimal(1, 0)) },
+		"mul": func() { max.Mul(aktiva.NewDecimal(2, 0)) },
+		"div": func() { max.Div(aktiva.MustParseDecimal("0.5")) },
+		"new": func() { aktiva.NewDecimal(1e12, 0) },
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected a panic")
+				}
+			}()
+			fn()
+		})
+	}
+
+	if !max.Sub(aktiva.NewDecimal(1, 0)).Add(aktiva.NewDecimal(1, 0)).Equal(max) {
+		t.Error("expected max - 1 + 1 to be max")
+	}
+}
@@ -0,0 +1,150 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewDeleteGLBatchRequest() DeleteGLBatchRequest {
+	r := DeleteGLBatchRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewDeleteGLBatchQueryParams()
+	r.pathParams = r.NewDeleteGLBatchPathParams()
+	r.requestBody = r.NewDeleteGLBatchRequestBody()
+	return r
+}
+
+type DeleteGLBatchRequest struct {
+	client      *Client
+	queryParams *DeleteGLBatchQueryParams
+	pathParams  *DeleteGLBatchPathParams
+	method      string
+	headers     http.Header
+	requestBody DeleteGLBatchRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r DeleteGLBatchRequest) Clone() DeleteGLBatchRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r DeleteGLBatchRequest) NewDeleteGLBatchQueryParams() *DeleteGLBatchQueryParams {
+	return &DeleteGLBatchQueryParams{}
+}
+
+type DeleteGLBatchQueryParams struct {
+}
+
+func (p DeleteGLBatchQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *DeleteGLBatchRequest) QueryParams() *DeleteGLBatchQueryParams {
+	return r.queryParams
+}
+
+func (r DeleteGLBatchRequest) NewDeleteGLBatchPathParams() *DeleteGLBatchPathParams {
+	return &DeleteGLBatchPathParams{}
+}
+
+type DeleteGLBatchPathParams struct {
+}
+
+func (p *DeleteGLBatchPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *DeleteGLBatchRequest) PathParams() *DeleteGLBatchPathParams {
+	return r.pathParams
+}
+
+func (r *DeleteGLBatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *DeleteGLBatchRequest) Method() string {
+	return r.method
+}
+
+func (r DeleteGLBatchRequest) NewDeleteGLBatchRequestBody() DeleteGLBatchRequestBody {
+	return DeleteGLBatchRequestBody{}
+}
+
+type DeleteGLBatchRequestBody struct {
+	ID uuid.UUID `json:"Id"`
+}
+
+func (r *DeleteGLBatchRequest) RequestBody() *DeleteGLBatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *DeleteGLBatchRequest) SetRequestBody(body DeleteGLBatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *DeleteGLBatchRequest) NewResponseBody() *DeleteGLBatchResponseBody {
+	return &DeleteGLBatchResponseBody{}
+}
+
+type DeleteGLBatchResponseBody struct{}
+
+func (r *DeleteGLBatchRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("deleteglbatch", r.PathParams())
+}
+
+func (r *DeleteGLBatchRequest) Do(ctx context.Context, opts ...RequestOption) (DeleteGLBatchResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
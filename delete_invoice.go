@@ -0,0 +1,150 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewDeleteInvoiceRequest() DeleteInvoiceRequest {
+	r := DeleteInvoiceRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewDeleteInvoiceQueryParams()
+	r.pathParams = r.NewDeleteInvoicePathParams()
+	r.requestBody = r.NewDeleteInvoiceRequestBody()
+	return r
+}
+
+type DeleteInvoiceRequest struct {
+	client      *Client
+	queryParams *DeleteInvoiceQueryParams
+	pathParams  *DeleteInvoicePathParams
+	method      string
+	headers     http.Header
+	requestBody DeleteInvoiceRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r DeleteInvoiceRequest) Clone() DeleteInvoiceRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r DeleteInvoiceRequest) NewDeleteInvoiceQueryParams() *DeleteInvoiceQueryParams {
+	return &DeleteInvoiceQueryParams{}
+}
+
+type DeleteInvoiceQueryParams struct {
+}
+
+func (p DeleteInvoiceQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *DeleteInvoiceRequest) QueryParams() *DeleteInvoiceQueryParams {
+	return r.queryParams
+}
+
+func (r DeleteInvoiceRequest) NewDeleteInvoicePathParams() *DeleteInvoicePathParams {
+	return &DeleteInvoicePathParams{}
+}
+
+type DeleteInvoicePathParams struct {
+}
+
+func (p *DeleteInvoicePathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *DeleteInvoiceRequest) PathParams() *DeleteInvoicePathParams {
+	return r.pathParams
+}
+
+func (r *DeleteInvoiceRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *DeleteInvoiceRequest) Method() string {
+	return r.method
+}
+
+func (r DeleteInvoiceRequest) NewDeleteInvoiceRequestBody() DeleteInvoiceRequestBody {
+	return DeleteInvoiceRequestBody{}
+}
+
+type DeleteInvoiceRequestBody struct {
+	ID uuid.UUID `json:"Id"`
+}
+
+func (r *DeleteInvoiceRequest) RequestBody() *DeleteInvoiceRequestBody {
+	return &r.requestBody
+}
+
+func (r *DeleteInvoiceRequest) SetRequestBody(body DeleteInvoiceRequestBody) {
+	r.requestBody = body
+}
+
+func (r *DeleteInvoiceRequest) NewResponseBody() *DeleteInvoiceResponseBody {
+	return &DeleteInvoiceResponseBody{}
+}
+
+type DeleteInvoiceResponseBody struct{}
+
+func (r *DeleteInvoiceRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("deleteinvoice", r.PathParams())
+}
+
+func (r *DeleteInvoiceRequest) Do(ctx context.Context, opts ...RequestOption) (DeleteInvoiceResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
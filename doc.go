@@ -0,0 +1,31 @@
+// Package aktiva is a client for the Merit Aktiva API.
+//
+// Every endpoint has its own request type, created from the client:
+//
+//	req := client.NewGetCustomersRequest()
+//	req.RequestBody().Name = "Omniboost"
+//	customers, err := req.Do(ctx)
+//
+// Do doesn't change the request, so one request can be sent by several
+// goroutines at once. A request must not be changed while one of its Do calls
+// runs though: to send variations of a request concurrently give every
+// goroutine its own Clone.
+//
+// This package only depends on what is needed to make HTTP calls. Optional
+// subsystems live in their own packages (lint, archive, money, pool, cache,
+// idempotency) so they're only compiled in when imported; subsystems that
+// need heavy third party dependencies belong in a separate module instead of
+// this one.
+//
+// Tracing isn't built in: OpenTelemetry would pull its SDK into every user of
+// this package. The otelaktiva module traces a client with spans per call and
+// per attempt:
+//
+//	otelaktiva.Instrument(client, otelaktiva.WithTracerProvider(tp))
+//
+// Other tracers can do the same with Client.Use for the calls and
+// Client.SetAttemptHook for the attempts, middleware doesn't see the retries.
+//
+// Undocumented endpoints live in the experimental package and need
+// Client.SetExperimental(true).
+package aktiva
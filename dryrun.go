@@ -0,0 +1,74 @@
+package aktiva
+
+import (
+	"net/http"
+	"net/http/httputil"
+	"strings"
+)
+
+// DryRunFunc receives the write requests that aren't sent in dry-run mode,
+// with their uncompressed body. An error is returned by Do, e.g. to report a
+// validation failure.
+type DryRunFunc func(req *http.Request, body []byte) error
+
+// SetDryRun builds and signs write requests (send, update and delete
+// endpoints) but doesn't send them. Do returns an empty response body and a
+// response with a "X-Dry-Run: true" header. Requests of other endpoints are
+// sent as usual, so a sync can read its data from the API.
+//
+// The requests are passed to fn, or logged at the info level when fn is nil.
+func (c *Client) SetDryRun(dryRun bool, fn DryRunFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dryRun = dryRun
+	c.dryRunFunc = fn
+}
+
+func (c *Client) DryRun() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.dryRun
+}
+
+// isWriteEndpoint reports whether the endpoint changes data in Merit. All
+// reading endpoints start with "get".
+func isWriteEndpoint(endpoint string) bool {
+	return !strings.HasPrefix(endpoint, "get")
+}
+
+// doDryRun hands req to the dry-run function instead of sending it
+func (c *Client) doDryRun(req *http.Request) (*http.Response, error) {
+	c.mu.RLock()
+	fn := c.dryRunFunc
+	c.mu.RUnlock()
+
+	body := []byte{}
+	if req.GetBody != nil {
+		payload, err := requestPayload(req)
+		if err != nil {
+			return nil, err
+		}
+		body = payload
+	}
+
+	if fn != nil {
+		err := fn(req, body)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		dump, _ := httputil.DumpRequestOut(req, false)
+		c.Logger().Info("dry run", "request_id", RequestID(req), "request", string(redactDump(dump)), "body", string(body))
+	}
+
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"X-Dry-Run": []string{"true"}},
+		Body:       http.NoBody,
+		Request:    req,
+	}, nil
+}
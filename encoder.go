@@ -0,0 +1,442 @@
+package aktiva
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+)
+
+// Encoder writes the request body. NewRequest calls it for every request with
+// a body and the output is what gets signed.
+type Encoder interface {
+	Encode(w io.Writer, v interface{}) error
+}
+
+// EncoderFunc is an adapter to use an ordinary function as an Encoder
+type EncoderFunc func(w io.Writer, v interface{}) error
+
+func (f EncoderFunc) Encode(w io.Writer, v interface{}) error {
+	return f(w, v)
+}
+
+// JSONEncoder is the default encoder of the client. Unlike encoding/json it
+// doesn't escape HTML by default: Merit stores & in item descriptions as the
+// literal &.
+type JSONEncoder struct {
+	// EscapeHTML escapes <, > and & in strings like encoding/json does
+	EscapeHTML bool
+	// OmitEmpty drops object members when it returns true. The value is the
+	// encoded JSON of the member. OmitEmptyValues drops null, "", [] and {}.
+	OmitEmpty func(key string, value json.RawMessage) bool
+
+	mu         sync.RWMutex
+	marshalers map[reflect.Type]func(v interface{}) ([]byte, error)
+}
+
+// NewJSONEncoder returns an encoder that doesn't escape HTML and keeps all
+// values
+func NewJSONEncoder() *JSONEncoder {
+	return &JSONEncoder{}
+}
+
+// RegisterMarshaler encodes all values with the type of sample with fn,
+// including values nested in structs, slices and maps and in exported
+// embedded structs. Structs containing such a value are encoded with their
+// members sorted by name. When such a struct has its own MarshalJSON, the
+// members of its output named like a field holding a registered value are
+// encoded from that field; members it leaves out stay out. Register types
+// before the encoder is used.
+func (e *JSONEncoder) RegisterMarshaler(sample interface{}, fn func(v interface{}) ([]byte, error)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.marshalers == nil {
+		e.marshalers = map[reflect.Type]func(v interface{}) ([]byte, error){}
+	}
+	e.marshalers[reflect.TypeOf(sample)] = fn
+}
+
+func (e *JSONEncoder) marshaler(t reflect.Type) func(v interface{}) ([]byte, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.marshalers[t]
+}
+
+func (e *JSONEncoder) hasMarshalers() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return len(e.marshalers) > 0
+}
+
+func (e *JSONEncoder) Encode(w io.Writer, v interface{}) error {
+	if e.hasMarshalers() {
+		v = e.wrap(reflect.ValueOf(v))
+	}
+
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(e.EscapeHTML)
+	err := enc.Encode(v)
+	if err != nil {
+		return err
+	}
+
+	if e.OmitEmpty == nil {
+		_, err = w.Write(buf.Bytes())
+		return err
+	}
+
+	out := new(bytes.Buffer)
+	dec := json.NewDecoder(buf)
+	dec.UseNumber()
+	err = e.omit(dec, out)
+	if err != nil {
+		return err
+	}
+
+	out.WriteByte('\n')
+	_, err = w.Write(out.Bytes())
+	return err
+}
+
+// wrap replaces the values with a registered marshaler by their encoding.
+// Structs are only walked when they contain such a value so json tags and
+// MarshalJSON methods of the other types keep working.
+func (e *JSONEncoder) wrap(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	if fn := e.marshaler(v.Type()); fn != nil {
+		b, err := fn(v.Interface())
+		if err != nil {
+			return marshalError{err: err}
+		}
+		return json.RawMessage(b)
+	}
+
+	walks := e.walks(v.Type(), map[reflect.Type]bool{})
+
+	// Structs with their own MarshalJSON, like InvoiceRow, are still walked
+	// when they contain registered values: wrapStruct encodes them with
+	// their method and replaces the members that have a json name of such a
+	// field. Other types are left to their method.
+	if !walks || !isStruct(v.Type()) {
+		// Date and the other types of this package implement MarshalJSON
+		// on the pointer, the method is lost when encoding an unaddressable
+		// copy
+		if v.Kind() != reflect.Ptr && reflect.PtrTo(v.Type()).Implements(marshalerType) {
+			return addressable(v).Addr().Interface()
+		}
+		if v.Type().Implements(marshalerType) {
+			return v.Interface()
+		}
+	}
+
+	if !walks {
+		return v.Interface()
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return e.wrap(v.Elem())
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = e.wrap(v.Index(i))
+		}
+		return items
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		m := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			m[fmt.Sprint(iter.Key().Interface())] = e.wrap(iter.Value())
+		}
+		return m
+	case reflect.Struct:
+		return e.wrapStruct(v)
+	}
+
+	return v.Interface()
+}
+
+var marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// addressable returns v, or an addressable copy of v
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v
+	}
+	p := reflect.New(v.Type())
+	p.Elem().Set(v)
+	return p.Elem()
+}
+
+// walks reports whether t contains a type with a registered marshaler
+func (e *JSONEncoder) walks(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if e.marshaler(t) != nil {
+		return true
+	}
+	if seen[t] {
+		return false
+	}
+	seen[t] = true
+
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return e.walks(t.Elem(), seen)
+	case reflect.Interface:
+		return true
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if e.walks(t.Field(i).Type, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// wrapStruct encodes the struct without the registered fields and merges the
+// registered fields back in under their json names
+func (e *JSONEncoder) wrapStruct(v reflect.Value) interface{} {
+	v = addressable(v)
+
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(e.EscapeHTML)
+	err := enc.Encode(v.Addr().Interface())
+	if err != nil {
+		return marshalError{err: err}
+	}
+	b := bytes.TrimRight(buf.Bytes(), "\n")
+
+	m := map[string]json.RawMessage{}
+	err = json.Unmarshal(b, &m)
+	if err != nil {
+		// the struct marshals itself to something other than an object
+		return json.RawMessage(b)
+	}
+
+	out := make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		out[k] = raw
+	}
+
+	// the members of embedded structs are promoted into this object, unless
+	// this struct has a member with the same name
+	direct := map[string]int{}
+	embedded := []int{}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		name := ""
+		if tag, ok := f.Tag.Lookup("json"); ok {
+			if tag == "-" {
+				continue
+			}
+			name = splitTag(tag)
+		}
+
+		if name == "" && f.Anonymous && isStruct(f.Type) {
+			embedded = append(embedded, i)
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		direct[name] = i
+	}
+
+	for _, i := range embedded {
+		if !e.walks(t.Field(i).Type, map[reflect.Type]bool{}) {
+			continue
+		}
+
+		field := v.Field(i)
+		if !field.CanInterface() {
+			continue
+		}
+		if field.Kind() == reflect.Ptr {
+			if field.IsNil() {
+				continue
+			}
+			field = field.Elem()
+		}
+
+		wrapped, ok := e.wrapStruct(field).(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for k, value := range wrapped {
+			if _, ok := direct[k]; ok {
+				continue
+			}
+			if _, ok := out[k]; ok {
+				out[k] = value
+			}
+		}
+	}
+
+	for name, i := range direct {
+		if _, ok := out[name]; !ok {
+			continue
+		}
+		if e.walks(t.Field(i).Type, map[reflect.Type]bool{}) {
+			out[name] = e.wrap(v.Field(i))
+		}
+	}
+
+	return out
+}
+
+func isStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
+
+func splitTag(tag string) string {
+	for i := 0; i < len(tag); i++ {
+		if tag[i] == ',' {
+			return tag[:i]
+		}
+	}
+	return tag
+}
+
+// marshalError fails the encoding with the error of a registered marshaler
+type marshalError struct {
+	err error
+}
+
+func (m marshalError) MarshalJSON() ([]byte, error) {
+	return nil, m.err
+}
+
+// omit copies the next value of dec to out without the object members
+// OmitEmpty drops, keeping the order of the other members
+func (e *JSONEncoder) omit(dec *json.Decoder, out *bytes.Buffer) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		out.WriteByte('{')
+		first := true
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			key, _ := tok.(string)
+
+			value := new(bytes.Buffer)
+			err = e.omit(dec, value)
+			if err != nil {
+				return err
+			}
+
+			if e.OmitEmpty(key, value.Bytes()) {
+				continue
+			}
+
+			if !first {
+				out.WriteByte(',')
+			}
+			first = false
+			err = e.writeToken(out, key)
+			if err != nil {
+				return err
+			}
+			out.WriteByte(':')
+			out.Write(value.Bytes())
+		}
+		_, err = dec.Token()
+		out.WriteByte('}')
+		return err
+	case json.Delim('['):
+		out.WriteByte('[')
+		for i := 0; dec.More(); i++ {
+			if i > 0 {
+				out.WriteByte(',')
+			}
+			err = e.omit(dec, out)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = dec.Token()
+		out.WriteByte(']')
+		return err
+	}
+
+	return e.writeToken(out, tok)
+}
+
+func (e *JSONEncoder) writeToken(out *bytes.Buffer, tok json.Token) error {
+	if n, ok := tok.(json.Number); ok {
+		out.WriteString(n.String())
+		return nil
+	}
+
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(e.EscapeHTML)
+	err := enc.Encode(tok)
+	if err != nil {
+		return err
+	}
+	out.Write(bytes.TrimRight(buf.Bytes(), "\n"))
+	return nil
+}
+
+// OmitEmptyValues drops members that are null, an empty string, an empty
+// array or an empty object. Zeros and false are kept: they are meaningful to
+// the API.
+func OmitEmptyValues(key string, value json.RawMessage) bool {
+	switch string(value) {
+	case "null", `""`, "[]", "{}":
+		return true
+	}
+	return false
+}
+
+// Encoder returns the encoder of the request bodies, a *JSONEncoder unless it
+// was replaced
+func (c *Client) Encoder() Encoder {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.encoder
+}
+
+// SetEncoder replaces the encoder of the request bodies, nil restores a new
+// JSONEncoder
+func (c *Client) SetEncoder(encoder Encoder) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if encoder == nil {
+		encoder = NewJSONEncoder()
+	}
+	c.encoder = encoder
+}
@@ -0,0 +1,162 @@
+package aktiva_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+type testAmount float64
+
+// testPriced leaves out Cost, like InvoiceRow leaves out fields of some rows
+type testPriced struct {
+	Price testAmount
+	Cost  testAmount
+}
+
+func (p testPriced) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct{ Price float64 }{float64(p.Price)})
+}
+
+func TestJSONEncoder(t *testing.T) {
+	type row struct {
+		Description string     `json:"Description"`
+		Code        string     `json:"Code,omitempty"`
+		Price       testAmount `json:"Price"`
+		Quantity    float64    `json:"Quantity"`
+		Tags        []string   `json:"Tags"`
+	}
+
+	tests := []struct {
+		name     string
+		encoder  func() *aktiva.JSONEncoder
+		expected string
+	}{
+		{
+			name:     "default",
+			encoder:  aktiva.NewJSONEncoder,
+			expected: `{"Description":"Fish & chips","Price":1.5,"Quantity":0,"Tags":null}`,
+		},
+		{
+			name: "escape html",
+			encoder: func() *aktiva.JSONEncoder {
+				return &aktiva.JSONEncoder{EscapeHTML: true}
+			},
+			expected: `{"Description":"Fish \u0026 chips","Price":1.5,"Quantity":0,"Tags":null}`,
+		},
+		{
+			name: "omit empty",
+			encoder: func() *aktiva.JSONEncoder {
+				return &aktiva.JSONEncoder{OmitEmpty: aktiva.OmitEmptyValues}
+			},
+			expected: `{"Description":"Fish & chips","Price":1.5,"Quantity":0}`,
+		},
+		{
+			name: "marshaler",
+			encoder: func() *aktiva.JSONEncoder {
+				e := aktiva.NewJSONEncoder()
+				e.RegisterMarshaler(testAmount(0), func(v interface{}) ([]byte, error) {
+					return []byte(strconv.FormatFloat(float64(v.(testAmount)), 'f', 2, 64)), nil
+				})
+				return e
+			},
+			expected: `{"Description":"Fish & chips","Price":1.50,"Quantity":0,"Tags":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			err := tt.encoder().Encode(buf, []row{{Description: "Fish & chips", Price: 1.5}})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected := "[" + tt.expected + "]\n"
+			if buf.String() != expected {
+				t.Errorf("expected %s, got %s", expected, buf.String())
+			}
+
+			if !json.Valid(buf.Bytes()) {
+				t.Errorf("invalid json %s", buf.String())
+			}
+		})
+	}
+}
+
+func TestJSONEncoderMarshalerKeepsDate(t *testing.T) {
+	type Base struct {
+		DocDate aktiva.Date
+		Price   testAmount
+	}
+	type row struct {
+		Base
+		DueDate  aktiva.Date
+		Total    testAmount
+		Optional *aktiva.Date `json:",omitempty"`
+	}
+
+	e := aktiva.NewJSONEncoder()
+	e.RegisterMarshaler(testAmount(0), func(v interface{}) ([]byte, error) {
+		return []byte(strconv.FormatFloat(float64(v.(testAmount)), 'f', 2, 64)), nil
+	})
+
+	date := aktiva.Date{Time: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)}
+	buf := new(bytes.Buffer)
+	err := e.Encode(buf, row{
+		Base:    Base{DocDate: date, Price: 1.5},
+		DueDate: date,
+		Total:   3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"DocDate":"20200301","DueDate":"20200301","Price":1.50,"Total":3.00}` + "\n"
+	if buf.String() != expected {
+		t.Errorf("expected %s, got %s", expected, buf.String())
+	}
+}
+
+func TestJSONEncoderMarshalerInsideMarshalJSON(t *testing.T) {
+	e := aktiva.NewJSONEncoder()
+	e.RegisterMarshaler(testAmount(0), func(v interface{}) ([]byte, error) {
+		return []byte(strconv.FormatFloat(float64(v.(testAmount)), 'f', 2, 64)), nil
+	})
+	e.RegisterMarshaler(aktiva.Decimal{}, func(v interface{}) ([]byte, error) {
+		return []byte(`"` + v.(aktiva.Decimal).StringFixed(2) + `"`), nil
+	})
+
+	buf := new(bytes.Buffer)
+	err := e.Encode(buf, []interface{}{testPriced{Price: 1.5, Cost: 1}, &testPriced{Price: 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != `[{"Price":1.50},{"Price":2.00}]`+"\n" {
+		t.Errorf("unexpected encoding %s", buf.String())
+	}
+
+	row := aktiva.NewServiceInvoiceRow("EXAMPLE", "Example consultancy", aktiva.NewDecimal(80, 0), uuid.Nil)
+	buf.Reset()
+	err = e.Encode(buf, aktiva.InvoiceRows{row})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows := []map[string]interface{}{}
+	err = json.Unmarshal(buf.Bytes(), &rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows[0]["Price"] != "80.00" {
+		t.Errorf("expected the registered marshaler for the price, got %s", buf.String())
+	}
+	if _, ok := rows[0]["ItemCostAmount"]; ok {
+		t.Errorf("expected MarshalJSON to leave out the stock fields, got %s", buf.String())
+	}
+}
@@ -0,0 +1,341 @@
+package aktiva
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Sentinel errors matched by errors.Is against the errors returned by Do.
+// They're derived from the HTTP status and the Merit message, so one error can
+// match several, e.g. a closed period is both ErrValidation and
+// ErrPeriodLocked.
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrValidation   = errors.New("validation failed")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrPeriodLocked = errors.New("period locked")
+)
+
+// unauthorizedMessages are (lowercase) fragments of the messages Merit sends
+// with a 200 range or 400 status when the credentials or signature are wrong
+var unauthorizedMessages = []string{
+	"apiid",
+	"api id",
+	"signature",
+	"unauthorized",
+	"authentication",
+}
+
+// statusIs reports whether the HTTP status of a Merit error matches target
+func statusIs(status int, target error) bool {
+	switch target {
+	case ErrNotFound:
+		return status == http.StatusNotFound
+	case ErrUnauthorized:
+		return status == http.StatusUnauthorized || status == http.StatusForbidden
+	case ErrValidation:
+		return status == http.StatusBadRequest || status == http.StatusUnprocessableEntity
+	case ErrRateLimited:
+		return status == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// Is matches the sentinel errors by status code and message, and the errors
+// in r.Errors like Unwrap does on Go 1.20 and later
+func (r *ErrorResponse) Is(target error) bool {
+	if r.Response != nil && statusIs(r.Response.StatusCode, target) {
+		return true
+	}
+
+	for _, err := range r.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first Merit error of the response that matches target. Go
+// only follows Unwrap() []error since 1.20, As keeps errors.As working on
+// older versions.
+func (r *ErrorResponse) As(target interface{}) bool {
+	for _, err := range r.Errors {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Is matches ErrUnauthorized for signature and credential messages
+func (e Error) Is(target error) bool {
+	if target != ErrUnauthorized {
+		return false
+	}
+
+	msg := strings.ToLower(e.Message + " " + e.MessageDetail)
+	for _, fragment := range unauthorizedMessages {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrorCode is a stable, language independent identifier for a known Merit
+// error message
+type ErrorCode string
+
+const (
+	ErrorCodeUnknown          ErrorCode = ""
+	ErrorCodeCustomerNotFound ErrorCode = "customer_not_found"
+	ErrorCodeItemCodeMissing  ErrorCode = "item_code_missing"
+	ErrorCodePeriodClosed     ErrorCode = "period_closed"
+	ErrorCodeDuplicateNumber  ErrorCode = "duplicate_number"
+	ErrorCodeVendorNotFound   ErrorCode = "vendor_not_found"
+	ErrorCodeItemNotFound     ErrorCode = "item_not_found"
+	ErrorCodeAccountNotFound  ErrorCode = "account_not_found"
+	ErrorCodeTaxFreeAmount    ErrorCode = "tax_free_amount"
+	ErrorCodeInvalidSignature ErrorCode = "invalid_signature"
+	ErrorCodeInvalidTimestamp ErrorCode = "invalid_timestamp"
+	// The endpoint isn't part of the company's Merit plan
+	ErrorCodeFeatureUnavailable ErrorCode = "feature_unavailable"
+)
+
+// errorMessages maps (lowercase) fragments of Merit error messages to error
+// codes. The language of the messages depends on the company settings.
+var errorMessages = []struct {
+	lang     string
+	fragment string
+	code     ErrorCode
+}{
+	// English
+	{"en", "not available in your package", ErrorCodeFeatureUnavailable},
+	{"en", "not included in your package", ErrorCodeFeatureUnavailable},
+	{"en", "not available in your plan", ErrorCodeFeatureUnavailable},
+	{"en", "upgrade your package", ErrorCodeFeatureUnavailable},
+	{"en", "customer not found", ErrorCodeCustomerNotFound},
+	{"en", "item code missing", ErrorCodeItemCodeMissing},
+	{"en", "item code is missing", ErrorCodeItemCodeMissing},
+	{"en", "period closed", ErrorCodePeriodClosed},
+	{"en", "period is closed", ErrorCodePeriodClosed},
+	{"en", "duplicate number", ErrorCodeDuplicateNumber},
+	{"en", "number already exists", ErrorCodeDuplicateNumber},
+	{"en", "vendor not found", ErrorCodeVendorNotFound},
+	{"en", "item not found", ErrorCodeItemNotFound},
+	{"en", "account not found", ErrorCodeAccountNotFound},
+	{"en", "tax free amount", ErrorCodeTaxFreeAmount},
+	{"en", "signature", ErrorCodeInvalidSignature},
+	{"en", "timestamp", ErrorCodeInvalidTimestamp},
+
+	// Estonian
+	{"et", "ei ole teie paketis", ErrorCodeFeatureUnavailable},
+	{"et", "paketis ei ole saadaval", ErrorCodeFeatureUnavailable},
+	{"et", "klienti ei leitud", ErrorCodeCustomerNotFound},
+	{"et", "klient puudub", ErrorCodeCustomerNotFound},
+	{"et", "artikli kood puudub", ErrorCodeItemCodeMissing},
+	{"et", "periood on suletud", ErrorCodePeriodClosed},
+	{"et", "periood suletud", ErrorCodePeriodClosed},
+	{"et", "number on juba olemas", ErrorCodeDuplicateNumber},
+	{"et", "number juba kasutusel", ErrorCodeDuplicateNumber},
+	{"et", "hankijat ei leitud", ErrorCodeVendorNotFound},
+	{"et", "hankija puudub", ErrorCodeVendorNotFound},
+	{"et", "artiklit ei leitud", ErrorCodeItemNotFound},
+	{"et", "artikkel puudub", ErrorCodeItemNotFound},
+	{"et", "kontot ei leitud", ErrorCodeAccountNotFound},
+	{"et", "konto puudub", ErrorCodeAccountNotFound},
+	{"et", "maksuvaba summa", ErrorCodeTaxFreeAmount},
+	{"et", "vale allkiri", ErrorCodeInvalidSignature},
+	{"et", "ajatempel", ErrorCodeInvalidTimestamp},
+
+	// Finnish
+	{"fi", "ei sisälly pakettiisi", ErrorCodeFeatureUnavailable},
+	{"fi", "asiakasta ei löytynyt", ErrorCodeCustomerNotFound},
+	{"fi", "asiakasta ei löydy", ErrorCodeCustomerNotFound},
+	{"fi", "nimikekoodi puuttuu", ErrorCodeItemCodeMissing},
+	{"fi", "tuotekoodi puuttuu", ErrorCodeItemCodeMissing},
+	{"fi", "kausi on suljettu", ErrorCodePeriodClosed},
+	{"fi", "jakso on lukittu", ErrorCodePeriodClosed},
+	{"fi", "numero on jo käytössä", ErrorCodeDuplicateNumber},
+	{"fi", "numero on jo olemassa", ErrorCodeDuplicateNumber},
+	{"fi", "aikaleima", ErrorCodeInvalidTimestamp},
+
+	// Polish
+	{"pl", "niedostępne w twoim pakiecie", ErrorCodeFeatureUnavailable},
+	{"pl", "nie znaleziono klienta", ErrorCodeCustomerNotFound},
+	{"pl", "brak kodu towaru", ErrorCodeItemCodeMissing},
+	{"pl", "brak kodu artykułu", ErrorCodeItemCodeMissing},
+	{"pl", "okres jest zamknięty", ErrorCodePeriodClosed},
+	{"pl", "okres zamknięty", ErrorCodePeriodClosed},
+	{"pl", "numer już istnieje", ErrorCodeDuplicateNumber},
+	{"pl", "zduplikowany numer", ErrorCodeDuplicateNumber},
+	{"pl", "znacznik czasu", ErrorCodeInvalidTimestamp},
+}
+
+// NormalizeErrorMessage returns the error code and the detected language
+// ("en", "et", "fi" or "pl") of a known Merit error message
+func NormalizeErrorMessage(msg string) (ErrorCode, string) {
+	msg = strings.ToLower(msg)
+	for _, m := range errorMessages {
+		if strings.Contains(msg, m.fragment) {
+			return m.code, m.lang
+		}
+	}
+	return ErrorCodeUnknown, ""
+}
+
+// ClassifyErrorMessage returns the error code of a known Merit error message
+func ClassifyErrorMessage(msg string) ErrorCode {
+	code, _ := NormalizeErrorMessage(msg)
+	return code
+}
+
+// Code returns the error code of the message, ErrorCodeUnknown when it isn't
+// recognized
+func (e Error) Code() ErrorCode {
+	return ClassifyErrorMessage(e.Message + " " + e.MessageDetail)
+}
+
+// ErrorCodeOf returns the code of the first recognized Merit message in err
+func ErrorCodeOf(err error) ErrorCode {
+	errorResponse := &ErrorResponse{}
+	if !errors.As(err, &errorResponse) {
+		return errorCodeOf(err)
+	}
+
+	for _, e := range errorResponse.Errors {
+		if code := errorCodeOf(e); code != ErrorCodeUnknown {
+			return code
+		}
+	}
+	return ErrorCodeUnknown
+}
+
+func errorCodeOf(err error) ErrorCode {
+	e := Error{}
+	if errors.As(err, &e) {
+		return e.Code()
+	}
+	return ErrorCodeUnknown
+}
+
+// CustomerNotFoundError is returned when the customer referenced by a document
+// doesn't exist
+type CustomerNotFoundError struct {
+	// Customer name, code or registration number as mentioned by Merit
+	Customer string
+	Cause    Error
+}
+
+func (e CustomerNotFoundError) Error() string {
+	return e.Cause.Error()
+}
+
+func (e CustomerNotFoundError) Unwrap() error {
+	return e.Cause
+}
+
+func (e CustomerNotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// ItemCodeMissingError is returned when a document row has no item code
+type ItemCodeMissingError struct {
+	// Item or row as mentioned by Merit
+	Item  string
+	Cause Error
+}
+
+func (e ItemCodeMissingError) Error() string {
+	return e.Cause.Error()
+}
+
+func (e ItemCodeMissingError) Unwrap() error {
+	return e.Cause
+}
+
+func (e ItemCodeMissingError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// PeriodClosedError is returned when the document date falls in a locked
+// period
+type PeriodClosedError struct {
+	// Period or date as mentioned by Merit
+	Period string
+	Cause  Error
+}
+
+func (e PeriodClosedError) Error() string {
+	return e.Cause.Error()
+}
+
+func (e PeriodClosedError) Unwrap() error {
+	return e.Cause
+}
+
+func (e PeriodClosedError) Is(target error) bool {
+	return target == ErrPeriodLocked || target == ErrValidation
+}
+
+// DuplicateNumberError is returned when a document with the same number
+// already exists
+type DuplicateNumberError struct {
+	// Document number as mentioned by Merit
+	Number string
+	Cause  Error
+}
+
+func (e DuplicateNumberError) Error() string {
+	return e.Cause.Error()
+}
+
+func (e DuplicateNumberError) Unwrap() error {
+	return e.Cause
+}
+
+func (e DuplicateNumberError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+var quotedValue = regexp.MustCompile(`["'“”]([^"'“”]+)["'“”]`)
+
+// errorValue extracts the subject (customer, number, ...) from a message:
+// either the first quoted value or the text after the last colon
+func errorValue(msg string) string {
+	if m := quotedValue.FindStringSubmatch(msg); m != nil {
+		return strings.TrimSpace(m[1])
+	}
+
+	if i := strings.LastIndex(msg, ":"); i >= 0 {
+		return strings.TrimSpace(msg[i+1:])
+	}
+
+	return ""
+}
+
+// newTypedError converts a Merit error into one of the domain specific errors
+// when the message is recognized
+func newTypedError(e Error) error {
+	msg := e.Message
+	if e.MessageDetail != "" {
+		msg = msg + " " + e.MessageDetail
+	}
+
+	switch ClassifyErrorMessage(msg) {
+	case ErrorCodeCustomerNotFound:
+		return CustomerNotFoundError{Customer: errorValue(msg), Cause: e}
+	case ErrorCodeItemCodeMissing:
+		return ItemCodeMissingError{Item: errorValue(msg), Cause: e}
+	case ErrorCodePeriodClosed:
+		return PeriodClosedError{Period: errorValue(msg), Cause: e}
+	case ErrorCodeDuplicateNumber:
+		return DuplicateNumberError{Number: errorValue(msg), Cause: e}
+	}
+
+	return e
+}
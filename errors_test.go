@@ -0,0 +1,203 @@
+package aktiva_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestTypedErrors(t *testing.T) {
+	b := []byte(`{"Message": "Duplicate number: INV-123"}`)
+	errorResponse := &aktiva.ErrorResponse{}
+	err := json.Unmarshal(b, errorResponse)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e, ok := errorResponse.Errors[0].(aktiva.DuplicateNumberError)
+	if !ok {
+		t.Fatalf("expected DuplicateNumberError, got %T", errorResponse.Errors[0])
+	}
+	if e.Number != "INV-123" {
+		t.Errorf("expected number INV-123, got %s", e.Number)
+	}
+
+	b = []byte(`{"Message": "Customer not found", "MessageDetail": "'Omniboost B.V.'"}`)
+	errorResponse = &aktiva.ErrorResponse{}
+	err = json.Unmarshal(b, errorResponse)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, ok := errorResponse.Errors[0].(aktiva.CustomerNotFoundError)
+	if !ok {
+		t.Fatalf("expected CustomerNotFoundError, got %T", errorResponse.Errors[0])
+	}
+	if c.Customer != "Omniboost B.V." {
+		t.Errorf("expected customer Omniboost B.V., got %s", c.Customer)
+	}
+}
+
+func TestNormalizeErrorMessage(t *testing.T) {
+	tests := []struct {
+		msg  string
+		code aktiva.ErrorCode
+		lang string
+	}{
+		{"Periood on suletud", aktiva.ErrorCodePeriodClosed, "et"},
+		{"Asiakasta ei löytynyt: ACME Oy", aktiva.ErrorCodeCustomerNotFound, "fi"},
+		{"Numer już istnieje", aktiva.ErrorCodeDuplicateNumber, "pl"},
+		{"Item code is missing", aktiva.ErrorCodeItemCodeMissing, "en"},
+		{"Maksuvaba summa ei saa olla suurem kui arve summa", aktiva.ErrorCodeTaxFreeAmount, "et"},
+		{"Hankijat ei leitud: Omniboost OÜ", aktiva.ErrorCodeVendorNotFound, "et"},
+		{"Something else", aktiva.ErrorCodeUnknown, ""},
+	}
+
+	for _, tt := range tests {
+		code, lang := aktiva.NormalizeErrorMessage(tt.msg)
+		if code != tt.code || lang != tt.lang {
+			t.Errorf("%q: expected %q/%q, got %q/%q", tt.msg, tt.code, tt.lang, code, lang)
+		}
+	}
+}
+
+func TestSentinelErrors(t *testing.T) {
+	tests := []struct {
+		status   int
+		body     string
+		expected []error
+	}{
+		{http.StatusNotFound, `{"Message": "No HTTP resource was found"}`, []error{aktiva.ErrNotFound}},
+		{http.StatusUnauthorized, `{"Message": "Authorization has been denied"}`, []error{aktiva.ErrUnauthorized}},
+		{http.StatusBadRequest, `{"Message": "Invalid signature"}`, []error{aktiva.ErrValidation, aktiva.ErrUnauthorized}},
+		{http.StatusBadRequest, `{"Message": "Periood on suletud"}`, []error{aktiva.ErrValidation, aktiva.ErrPeriodLocked}},
+		{http.StatusBadRequest, `{"Message": "Customer not found"}`, []error{aktiva.ErrValidation, aktiva.ErrNotFound}},
+		{http.StatusTooManyRequests, `{"Message": "Too many requests"}`, []error{aktiva.ErrRateLimited}},
+	}
+
+	all := []error{aktiva.ErrNotFound, aktiva.ErrUnauthorized, aktiva.ErrValidation, aktiva.ErrRateLimited, aktiva.ErrPeriodLocked}
+	for _, tt := range tests {
+		resp := &http.Response{
+			StatusCode: tt.status,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       ioutil.NopCloser(strings.NewReader(tt.body)),
+		}
+		err := fmt.Errorf("wrapped: %w", aktiva.CheckResponse(resp))
+
+		for _, sentinel := range all {
+			expected := false
+			for _, e := range tt.expected {
+				expected = expected || e == sentinel
+			}
+
+			if errors.Is(err, sentinel) != expected {
+				t.Errorf("%d %s: expected errors.Is(%v) to be %v", tt.status, tt.body, sentinel, expected)
+			}
+		}
+	}
+}
+
+func TestErrorResponseAs(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusBadRequest,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(strings.NewReader(`{"Message": "Duplicate number: INV-123"}`)),
+	}
+	errorResponse := aktiva.CheckResponse(resp).(*aktiva.ErrorResponse)
+
+	// the method itself, as used by errors.As before Go 1.20
+	duplicate := aktiva.DuplicateNumberError{}
+	if !errorResponse.As(&duplicate) || duplicate.Number != "INV-123" {
+		t.Errorf("expected a DuplicateNumberError for INV-123, got %+v", duplicate)
+	}
+
+	duplicate = aktiva.DuplicateNumberError{}
+	if !errors.As(fmt.Errorf("wrapped: %w", errorResponse), &duplicate) || duplicate.Number != "INV-123" {
+		t.Errorf("expected errors.As to find the DuplicateNumberError, got %+v", duplicate)
+	}
+
+	notFound := aktiva.CustomerNotFoundError{}
+	if errorResponse.As(&notFound) {
+		t.Error("expected no CustomerNotFoundError")
+	}
+}
+
+func TestErrorResponseError(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodPost, "https://aktiva.merit.ee/api/v1/sendinvoice?ApiId=id", nil)
+	resp := &http.Response{StatusCode: http.StatusBadRequest, Request: req}
+
+	tests := []struct {
+		err      aktiva.ErrorResponse
+		expected string
+	}{
+		{aktiva.ErrorResponse{}, "unknown error response"},
+		{aktiva.ErrorResponse{Response: resp}, "POST /api/v1/sendinvoice: 400 Bad Request"},
+		{
+			aktiva.ErrorResponse{Response: resp, Errors: []error{aktiva.Error{Message: "Periood on suletud"}, errors.New("second")}},
+			"POST /api/v1/sendinvoice: 400 Bad Request: Periood on suletud, second",
+		},
+	}
+
+	for _, tt := range tests {
+		if tt.err.Error() != tt.expected {
+			t.Errorf("expected %q, got %q", tt.expected, tt.err.Error())
+		}
+	}
+
+	if errs := tests[2].err.Unwrap(); len(errs) != 2 {
+		t.Errorf("expected 2 wrapped errors, got %d", len(errs))
+	}
+}
+
+func TestCheckResponseNonJSON(t *testing.T) {
+	tests := []struct {
+		contentType string
+		body        string
+		expected    string
+	}{
+		{"text/plain", "Invalid signature\r\n", "401 Unauthorized: Invalid signature"},
+		{
+			"text/html; charset=utf-8",
+			"<html><head><title>401 - Unauthorized</title><style>body {}</style></head><body><h2>Access is denied &amp; logged</h2></body></html>",
+			"401 Unauthorized: 401 - Unauthorized Access is denied & logged",
+		},
+		{"text/html", "", "401 Unauthorized"},
+	}
+
+	for _, tt := range tests {
+		resp := &http.Response{
+			Status:     "401 Unauthorized",
+			StatusCode: http.StatusUnauthorized,
+			Header:     http.Header{"Content-Type": []string{tt.contentType}},
+			Body:       ioutil.NopCloser(strings.NewReader(tt.body)),
+		}
+
+		err := aktiva.CheckResponse(resp)
+		if err == nil || err.Error() != tt.expected {
+			t.Errorf("expected %q, got %v", tt.expected, err)
+		}
+	}
+}
+
+func TestErrorCodeOf(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusBadRequest,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(strings.NewReader(`{"Message": "Periood on suletud"}`)),
+	}
+	err := &aktiva.EndpointError{Endpoint: "sendinvoice", Err: aktiva.CheckResponse(resp)}
+
+	if code := aktiva.ErrorCodeOf(err); code != aktiva.ErrorCodePeriodClosed {
+		t.Errorf("expected %q, got %q", aktiva.ErrorCodePeriodClosed, code)
+	}
+
+	if code := aktiva.ErrorCodeOf(errors.New("Periood on suletud")); code != aktiva.ErrorCodeUnknown {
+		t.Errorf("expected no code for other errors, got %q", code)
+	}
+}
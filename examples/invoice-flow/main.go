@@ -0,0 +1,196 @@
+// Command invoice-flow walks through the main parts of the API against a
+// Merit Aktiva demo company: it creates a service item, invoices it to a new
+// customer (optionally paid right away), and fetches the reports the invoice
+// shows up in.
+//
+// It reads the same environment variables as the tests (API_ID, API_KEY,
+// BASE_URL and DEBUG) and exits non-zero when a step fails, so it can be used
+// as an acceptance test:
+//
+//	API_ID=... API_KEY=... PAYMENT_METHOD="Bank" go run ./examples/invoice-flow
+//
+// Every run adds an invoice to the company. The item and customer are
+// created on the first run and reused after that, Merit's API can't delete
+// them. What a run creates is recorded in run-<id>.json in RUN_DIR (the
+// current directory by default), pass that file to aktiva-cleanup to delete
+// the run's invoice again.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/gofrs/uuid"
+	aktiva "github.com/omniboost/go-merit-aktiva"
+	"github.com/omniboost/go-merit-aktiva/internal/testrun"
+)
+
+func main() {
+	client := aktiva.NewClient(nil, os.Getenv("API_ID"), os.Getenv("API_KEY"))
+	if os.Getenv("DEBUG") != "" {
+		client.SetDebug(true)
+	}
+	if os.Getenv("BASE_URL") != "" {
+		baseURL, err := url.Parse(os.Getenv("BASE_URL"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		client.SetBaseURL(*baseURL)
+	}
+
+	dir := os.Getenv("RUN_DIR")
+	if dir == "" {
+		dir = "."
+	}
+	run := testrun.New(dir)
+
+	ctx := context.Background()
+	today := client.Date(time.Now())
+
+	// taxes are referenced by guid, look up the standard rate
+	taxesReq := client.NewGetTaxesRequest()
+	taxes, err := taxesReq.Do(ctx)
+	if err != nil {
+		log.Fatalf("fetching taxes: %s", err)
+	}
+	tax, ok := aktiva.Taxes(taxes).FindByPct(20)
+	if !ok {
+		log.Fatal("no 20% tax found")
+	}
+
+	// item, shared by all runs
+	price := aktiva.NewDecimal(80, 0)
+	itemCode, err := exampleItem(ctx, client, run, price)
+	if err != nil {
+		log.Fatalf("creating item: %s", err)
+	}
+
+	customer, err := exampleCustomer(ctx, client)
+	if err != nil {
+		log.Fatalf("looking up customer: %s", err)
+	}
+
+	// invoice, the customer is created with the first one
+	row := aktiva.NewServiceInvoiceRow(itemCode, "Example consultancy", price, tax.TaxID())
+	row.Quantity = aktiva.NewDecimal(2, 0)
+	net := row.Quantity.Mul(row.Price)
+	vat := net.Mul(aktiva.NewDecimalFromFloat(tax.TaxPct)).Div(aktiva.NewDecimal(100, 0)).Round(2)
+
+	invoiceReq := client.NewSendInvoiceRequest()
+	invoice := invoiceReq.RequestBody()
+	invoice.Customer = customer
+	invoice.DocDate = today
+	invoice.DueDate = client.Date(time.Now().AddDate(0, 0, 14))
+	invoice.InvoiceNo = "EX-" + run.ID
+	invoice.InvoiceRow = aktiva.InvoiceRows{row}
+	invoice.TaxAmount = aktiva.TaxAmounts{{TaxID: tax.TaxID(), Amount: vat}}
+	invoice.TotalAmount = net
+	if method := os.Getenv("PAYMENT_METHOD"); method != "" {
+		invoice.Payment = &aktiva.Payment{
+			PaymentMethod: method,
+			PaidAmount:    net.Add(vat),
+			PaymDate:      today,
+		}
+	}
+
+	created, err := invoiceReq.Do(ctx)
+	if err != nil {
+		log.Fatalf("creating invoice: %s", err)
+	}
+	fmt.Printf("created invoice %s for customer %s\n", created.InvoiceNo, created.CustomerID)
+	err = run.Add(testrun.KindInvoice, created.InvoiceID, created.InvoiceNo)
+	if err != nil {
+		log.Fatalf("recording run: %s", err)
+	}
+	if customer.ID == nil {
+		err = run.Add(testrun.KindCustomer, created.CustomerID, customer.Name)
+		if err != nil {
+			log.Fatalf("recording run: %s", err)
+		}
+	}
+
+	// reports
+	debtsReq := client.NewGetCustomerDebtsReportRequest()
+	debtsReq.RequestBody().DebtDate = today
+	debts, err := debtsReq.Do(ctx)
+	if err != nil {
+		log.Fatalf("fetching customer debts: %s", err)
+	}
+	for cur, amount := range aktiva.DebtReportRows(debts).UnpaidByCurrency() {
+		fmt.Printf("unpaid %s: %s\n", cur, amount.StringFixed(2))
+	}
+
+	salesReq := client.NewGetSalesReportRequest()
+	salesReq.RequestBody().PeriodStart = today
+	salesReq.RequestBody().PeriodEnd = today
+	salesReq.RequestBody().GroupBy = aktiva.SalesReportByItem
+	sales, err := salesReq.Do(ctx)
+	if err != nil {
+		log.Fatalf("fetching sales report: %s", err)
+	}
+	fmt.Printf("turnover today: %s\n", aktiva.SalesReportRows(sales).Total().StringFixed(2))
+	fmt.Printf("recorded run in %s, delete its invoice with aktiva-cleanup -run %s\n", run.Path(), run.Path())
+}
+
+const (
+	exampleItemCode     = "EXAMPLE"
+	exampleCustomerName = "Example customer"
+)
+
+// exampleItem returns the code of the example item, creating it when the
+// company doesn't have it yet
+func exampleItem(ctx context.Context, client *aktiva.Client, run *testrun.Run, price aktiva.Decimal) (string, error) {
+	req := client.NewGetItemsRequest()
+	req.RequestBody().Code = exampleItemCode
+	existing, err := req.Do(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(existing) > 0 {
+		return existing[0].Code, nil
+	}
+
+	itemsReq := client.NewSendItemsRequest()
+	itemsReq.RequestBody().Items = aktiva.NewItems{
+		{
+			Type:        aktiva.ItemTypeService,
+			Code:        exampleItemCode,
+			Description: "Example consultancy",
+			UOMName:     "h",
+			SalesPrice:  &price,
+		},
+	}
+	items, err := itemsReq.Do(ctx)
+	if err != nil {
+		return "", err
+	}
+	fmt.Printf("created item %s\n", items[0].Code)
+
+	return items[0].Code, run.Add(testrun.KindItem, items[0].ItemID.String(), items[0].Code)
+}
+
+// exampleCustomer returns the example customer, or the fields to create it
+// with the invoice when the company doesn't have it yet
+func exampleCustomer(ctx context.Context, client *aktiva.Client) (aktiva.NewInvoiceCustomer, error) {
+	req := client.NewGetCustomersRequest()
+	req.RequestBody().Name = exampleCustomerName
+	existing, err := req.Do(ctx)
+	if err != nil {
+		return aktiva.NewInvoiceCustomer{}, err
+	}
+
+	if len(existing) > 0 {
+		id := uuid.FromStringOrNil(existing[0].CustomerID)
+		return aktiva.NewInvoiceCustomer{ID: &id}, nil
+	}
+
+	return aktiva.NewInvoiceCustomer{
+		Name:          exampleCustomerName,
+		NotTDCustomer: true,
+		CountryCode:   "EE",
+	}, nil
+}
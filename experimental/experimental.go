@@ -0,0 +1,29 @@
+// Package experimental contains requests for Merit Aktiva endpoints that
+// aren't documented or whose responses are still being worked out.
+//
+// Nothing in this package is covered by the compatibility promise of the
+// aktiva package: requests and response types may change or disappear in any
+// release. Requests fail with ErrDisabled unless the client opted in:
+//
+//	client.SetExperimental(true)
+//	banks, err := experimental.NewGetBanksRequest(client).Do(ctx)
+//
+// Requests that turn out to be stable move to the aktiva package.
+package experimental
+
+import (
+	"errors"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+// ErrDisabled is returned by requests in this package when the client doesn't
+// have experimental endpoints enabled
+var ErrDisabled = errors.New("experimental endpoints are disabled, see Client.SetExperimental")
+
+func checkEnabled(c *aktiva.Client, endpoint string) error {
+	if c.Experimental() {
+		return nil
+	}
+	return &aktiva.EndpointError{Endpoint: endpoint, Err: ErrDisabled}
+}
@@ -0,0 +1,167 @@
+package experimental
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+// NewGetBanksRequest lists the bank accounts of the company.
+//
+// Experimental: the endpoint isn't documented by Merit and its response may
+// change without notice.
+func NewGetBanksRequest(c *aktiva.Client) GetBanksRequest {
+	r := GetBanksRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetBanksQueryParams()
+	r.pathParams = r.NewGetBanksPathParams()
+	r.requestBody = r.NewGetBanksRequestBody()
+	return r
+}
+
+type GetBanksRequest struct {
+	client      *aktiva.Client
+	queryParams *GetBanksQueryParams
+	pathParams  *GetBanksPathParams
+	method      string
+	headers     http.Header
+	requestBody GetBanksRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetBanksRequest) Clone() GetBanksRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetBanksRequest) NewGetBanksQueryParams() *GetBanksQueryParams {
+	return &GetBanksQueryParams{}
+}
+
+type GetBanksQueryParams struct {
+}
+
+func (p GetBanksQueryParams) ToURLValues() (url.Values, error) {
+	encoder := aktiva.NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetBanksRequest) QueryParams() *GetBanksQueryParams {
+	return r.queryParams
+}
+
+func (r GetBanksRequest) NewGetBanksPathParams() *GetBanksPathParams {
+	return &GetBanksPathParams{}
+}
+
+type GetBanksPathParams struct {
+}
+
+func (p *GetBanksPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetBanksRequest) PathParams() *GetBanksPathParams {
+	return r.pathParams
+}
+
+func (r *GetBanksRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetBanksRequest) Method() string {
+	return r.method
+}
+
+func (r GetBanksRequest) NewGetBanksRequestBody() GetBanksRequestBody {
+	return GetBanksRequestBody{}
+}
+
+type GetBanksRequestBody struct {
+}
+
+func (r *GetBanksRequest) RequestBody() *GetBanksRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetBanksRequest) SetRequestBody(body GetBanksRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetBanksRequest) NewResponseBody() *GetBanksResponseBody {
+	return &GetBanksResponseBody{}
+}
+
+type GetBanksResponseBody []Bank
+
+func (r *GetBanksRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getbanks", r.PathParams())
+}
+
+func (r *GetBanksRequest) Do(ctx context.Context, opts ...aktiva.RequestOption) (GetBanksResponseBody, error) {
+	ctx, cancel := aktiva.ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	err := checkEnabled(r.client, "getbanks")
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, nil)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+// Bank is a bank account of the company
+type Bank struct {
+	ID           string `json:"BankId"`
+	Name         string `json:"Name"`
+	IBANCode     string `json:"IBANCode"`
+	AccountCode  string `json:"AccountCode"`
+	CurrencyCode string `json:"CurrencyCode"`
+}
@@ -0,0 +1,45 @@
+package experimental_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+	"github.com/omniboost/go-merit-aktiva/experimental"
+)
+
+func TestGetBanks(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"BankId": "1", "Name": "LHV", "CurrencyCode": "EUR"}]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	req := experimental.NewGetBanksRequest(c)
+	_, err := req.Do(context.Background())
+	if !errors.Is(err, experimental.ErrDisabled) {
+		t.Fatalf("expected ErrDisabled, got %v", err)
+	}
+	if calls != 0 {
+		t.Errorf("expected no calls, got %d", calls)
+	}
+
+	c.SetExperimental(true)
+	banks, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(banks) != 1 || banks[0].Name != "LHV" {
+		t.Errorf("unexpected banks: %v", banks)
+	}
+}
@@ -0,0 +1,86 @@
+package aktiva
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrFeatureUnavailable is returned for endpoints that aren't part of the
+// company's Merit plan
+var ErrFeatureUnavailable = errors.New("feature not available")
+
+// FeatureUnavailableError is returned when Merit answered that an endpoint
+// isn't part of the company's plan. Once that happened the client doesn't call
+// the endpoint again and returns this error immediately, with a nil Err.
+type FeatureUnavailableError struct {
+	Endpoint string
+	// Response error of the call that marked the endpoint unavailable
+	Err error
+}
+
+func (e *FeatureUnavailableError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("%s: %s", ErrFeatureUnavailable, e.Endpoint)
+	}
+	return fmt.Sprintf("%s: %s: %s", ErrFeatureUnavailable, e.Endpoint, e.Err)
+}
+
+func (e *FeatureUnavailableError) Is(target error) bool {
+	return target == ErrFeatureUnavailable
+}
+
+func (e *FeatureUnavailableError) Unwrap() error {
+	return e.Err
+}
+
+// features caches the endpoints that turned out to be unavailable
+type features struct {
+	mu          sync.Mutex
+	unavailable map[string]bool
+}
+
+func (f *features) available(endpoint string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return !f.unavailable[endpoint]
+}
+
+func (f *features) markUnavailable(endpoint string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.unavailable == nil {
+		f.unavailable = map[string]bool{}
+	}
+	f.unavailable[endpoint] = true
+}
+
+func (f *features) reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.unavailable = nil
+}
+
+// FeatureAvailable reports whether endpoint hasn't been marked unavailable
+func (c *Client) FeatureAvailable(endpoint string) bool {
+	return c.features.available(endpoint)
+}
+
+// ResetFeatures forgets which endpoints were unavailable, e.g. after the
+// company upgraded its plan
+func (c *Client) ResetFeatures() {
+	c.features.reset()
+}
+
+// isFeatureUnavailableResponse reports whether err is Merit's answer for an
+// endpoint outside of the company's plan. A plain 403 or 404 isn't enough:
+// those are also sent for wrong credentials, a wrong base URL or a record
+// that doesn't exist, and would disable the endpoint for good.
+func isFeatureUnavailableResponse(err error) bool {
+	errorResponse := &ErrorResponse{}
+	if !errors.As(err, &errorResponse) || errorResponse.Response == nil {
+		return false
+	}
+
+	return ErrorCodeOf(errorResponse) == ErrorCodeFeatureUnavailable
+}
@@ -1,8 +1,11 @@
 package aktiva
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/omniboost/go-merit-aktiva/utils"
 )
@@ -29,6 +32,22 @@ type GetAccountsRequest struct {
 	requestBody GetAccountsRequestBody
 }
 
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetAccountsRequest) Clone() GetAccountsRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
 func (r GetAccountsRequest) NewGetAccountsQueryParams() *GetAccountsQueryParams {
 	return &GetAccountsQueryParams{}
 }
@@ -36,7 +55,7 @@ func (r GetAccountsRequest) NewGetAccountsQueryParams() *GetAccountsQueryParams
 type GetAccountsQueryParams struct{}
 
 func (p GetAccountsQueryParams) ToURLValues() (url.Values, error) {
-	encoder := utils.NewSchemaEncoder()
+	encoder := NewSchemaEncoder()
 	params := url.Values{}
 
 	err := encoder.Encode(p, params)
@@ -95,19 +114,31 @@ func (r *GetAccountsRequest) NewResponseBody() *GetAccountsResponseBody {
 
 type GetAccountsResponseBody Accounts
 
-func (r *GetAccountsRequest) URL() url.URL {
+func (r *GetAccountsRequest) URL() (url.URL, error) {
 	return r.client.GetEndpointURL("getaccounts", r.PathParams())
 }
 
-func (r *GetAccountsRequest) Do() (GetAccountsResponseBody, error) {
+func (r *GetAccountsRequest) Do(ctx context.Context, opts ...RequestOption) (GetAccountsResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
 	// Create http request
-	req, err := r.client.NewRequest(nil, r.Method(), r.URL(), nil)
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, nil)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
 
 	// Process query parameters
-	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
@@ -119,6 +150,32 @@ func (r *GetAccountsRequest) Do() (GetAccountsResponseBody, error) {
 
 type Accounts []Account
 
+// FindByCode returns the account with the given account code
+func (aa Accounts) FindByCode(code string) (Account, bool) {
+	for _, a := range aa {
+		if a.Code == code {
+			return a, true
+		}
+	}
+	return Account{}, false
+}
+
+// ValidateCodes checks that all account codes exist and are active, so GL
+// transactions can be validated before they are sent
+func (aa Accounts) ValidateCodes(codes ...string) error {
+	for _, code := range codes {
+		a, ok := aa.FindByCode(code)
+		if !ok {
+			return fmt.Errorf("unknown account code \"%s\"", code)
+		}
+
+		if !a.IsActive() {
+			return fmt.Errorf("account code \"%s\" is not active", code)
+		}
+	}
+	return nil
+}
+
 type Account struct {
 	AccountID        string `json:"AccountID"`
 	NonActive        string `json:"NonActive"`
@@ -128,3 +185,7 @@ type Account struct {
 	LinkedVendorName string `json:"LinkedVendorName"`
 	IsParent         string `json:"IsParent"`
 }
+
+func (a Account) IsActive() bool {
+	return strings.ToLower(a.NonActive) != "true"
+}
@@ -1,14 +1,17 @@
 package aktiva_test
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"testing"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
 )
 
 func TestGetAccounts(t *testing.T) {
 	req := client.NewGetAccountsRequest()
-	resp, err := req.Do()
+	resp, err := req.Do(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -16,3 +19,25 @@ func TestGetAccounts(t *testing.T) {
 	b, _ := json.MarshalIndent(resp, "", "  ")
 	log.Println(string(b))
 }
+
+func TestAccountsValidateCodes(t *testing.T) {
+	accounts := aktiva.Accounts{
+		{Code: "1000", Name: "Cash", NonActive: "false"},
+		{Code: "1340", Name: "Old receivables", NonActive: "true"},
+	}
+
+	err := accounts.ValidateCodes("1000")
+	if err != nil {
+		t.Error(err)
+	}
+
+	err = accounts.ValidateCodes("1000", "1340")
+	if err == nil {
+		t.Error("expected error for inactive account")
+	}
+
+	err = accounts.ValidateCodes("9999")
+	if err == nil {
+		t.Error("expected error for unknown account")
+	}
+}
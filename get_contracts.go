@@ -0,0 +1,199 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetContractsRequest() GetContractsRequest {
+	r := GetContractsRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetContractsQueryParams()
+	r.pathParams = r.NewGetContractsPathParams()
+	r.requestBody = r.NewGetContractsRequestBody()
+	return r
+}
+
+type GetContractsRequest struct {
+	client      *Client
+	queryParams *GetContractsQueryParams
+	pathParams  *GetContractsPathParams
+	method      string
+	headers     http.Header
+	requestBody GetContractsRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetContractsRequest) Clone() GetContractsRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetContractsRequest) NewGetContractsQueryParams() *GetContractsQueryParams {
+	return &GetContractsQueryParams{}
+}
+
+type GetContractsQueryParams struct {
+}
+
+func (p GetContractsQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetContractsRequest) QueryParams() *GetContractsQueryParams {
+	return r.queryParams
+}
+
+func (r GetContractsRequest) NewGetContractsPathParams() *GetContractsPathParams {
+	return &GetContractsPathParams{}
+}
+
+type GetContractsPathParams struct {
+}
+
+func (p *GetContractsPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetContractsRequest) PathParams() *GetContractsPathParams {
+	return r.pathParams
+}
+
+func (r *GetContractsRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetContractsRequest) Method() string {
+	return r.method
+}
+
+func (r GetContractsRequest) NewGetContractsRequestBody() GetContractsRequestBody {
+	return GetContractsRequestBody{}
+}
+
+type GetContractsRequestBody struct {
+	// Limit the list to contracts of this customer, all customers when empty
+	CustomerID *uuid.UUID `json:"CustomerId,omitempty"`
+}
+
+func (r *GetContractsRequest) RequestBody() *GetContractsRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetContractsRequest) SetRequestBody(body GetContractsRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetContractsRequest) NewResponseBody() *GetContractsResponseBody {
+	return &GetContractsResponseBody{}
+}
+
+type GetContractsResponseBody Contracts
+
+func (r *GetContractsRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getcontracts", r.PathParams())
+}
+
+func (r *GetContractsRequest) Do(ctx context.Context, opts ...RequestOption) (GetContractsResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type Contracts []Contract
+
+// Contract is a periodic invoicing agreement with a customer. An invoice with
+// the contract lines is generated every Interval months.
+type Contract struct {
+	ContractID   string        `json:"ContractId"`
+	ContractNo   string        `json:"ContractNo"`
+	CustomerID   string        `json:"CustomerId"`
+	CustomerName string        `json:"CustomerName"`
+	StartDate    Date          `json:"StartDate"`
+	EndDate      Date          `json:"EndDate"`
+	Interval     int           `json:"Interval"`
+	NextInvDate  Date          `json:"NextInvDate"`
+	CurrencyCode string        `json:"CurrencyCode"`
+	Lines        ContractLines `json:"Lines"`
+}
+
+// IsActive reports whether the contract generates invoices on date
+func (c Contract) IsActive(date time.Time) bool {
+	if !c.StartDate.IsEmpty() && date.Before(c.StartDate.Time) {
+		return false
+	}
+	if !c.EndDate.IsEmpty() && date.After(c.EndDate.Time) {
+		return false
+	}
+	return true
+}
+
+type ContractLines []ContractLine
+
+// ContractLine is an item invoiced on every invoice of the contract
+type ContractLine struct {
+	Item     Article   `json:"Item"`
+	Quantity Decimal   `json:"Quantity"`
+	Price    Decimal   `json:"Price"`
+	TaxID    uuid.UUID `json:"TaxId"`
+	// Lines are only invoiced between the start and end date, empty for the
+	// whole contract period
+	StartDate Date `json:"StartDate"`
+	EndDate   Date `json:"EndDate"`
+}
+
+// Amount returns the amount of the line excluding VAT
+func (l ContractLine) Amount() Decimal {
+	return l.Quantity.Mul(l.Price)
+}
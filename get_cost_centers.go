@@ -0,0 +1,158 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetCostCentersRequest() GetCostCentersRequest {
+	r := GetCostCentersRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetCostCentersQueryParams()
+	r.pathParams = r.NewGetCostCentersPathParams()
+	r.requestBody = r.NewGetCostCentersRequestBody()
+	return r
+}
+
+type GetCostCentersRequest struct {
+	client      *Client
+	queryParams *GetCostCentersQueryParams
+	pathParams  *GetCostCentersPathParams
+	method      string
+	headers     http.Header
+	requestBody GetCostCentersRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetCostCentersRequest) Clone() GetCostCentersRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetCostCentersRequest) NewGetCostCentersQueryParams() *GetCostCentersQueryParams {
+	return &GetCostCentersQueryParams{}
+}
+
+type GetCostCentersQueryParams struct {
+}
+
+func (p GetCostCentersQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetCostCentersRequest) QueryParams() *GetCostCentersQueryParams {
+	return r.queryParams
+}
+
+func (r GetCostCentersRequest) NewGetCostCentersPathParams() *GetCostCentersPathParams {
+	return &GetCostCentersPathParams{}
+}
+
+type GetCostCentersPathParams struct {
+}
+
+func (p *GetCostCentersPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetCostCentersRequest) PathParams() *GetCostCentersPathParams {
+	return r.pathParams
+}
+
+func (r *GetCostCentersRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetCostCentersRequest) Method() string {
+	return r.method
+}
+
+func (r GetCostCentersRequest) NewGetCostCentersRequestBody() GetCostCentersRequestBody {
+	return GetCostCentersRequestBody{}
+}
+
+type GetCostCentersRequestBody struct {
+}
+
+func (r *GetCostCentersRequest) RequestBody() *GetCostCentersRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetCostCentersRequest) SetRequestBody(body GetCostCentersRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetCostCentersRequest) NewResponseBody() *GetCostCentersResponseBody {
+	return &GetCostCentersResponseBody{}
+}
+
+type GetCostCentersResponseBody CostCenters
+
+func (r *GetCostCentersRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getcostcenters", r.PathParams())
+}
+
+func (r *GetCostCentersRequest) Do(ctx context.Context, opts ...RequestOption) (GetCostCentersResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type CostCenters []CostCenter
+
+// CostCenter is referenced by CostCenterCode on invoice and GL rows
+type CostCenter struct {
+	ID        string `json:"Id"`
+	Code      string `json:"Code"`
+	Name      string `json:"Name"`
+	NonActive bool   `json:"NonActive"`
+}
@@ -0,0 +1,166 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetCurrenciesRequest() GetCurrenciesRequest {
+	r := GetCurrenciesRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetCurrenciesQueryParams()
+	r.pathParams = r.NewGetCurrenciesPathParams()
+	r.requestBody = r.NewGetCurrenciesRequestBody()
+	return r
+}
+
+type GetCurrenciesRequest struct {
+	client      *Client
+	queryParams *GetCurrenciesQueryParams
+	pathParams  *GetCurrenciesPathParams
+	method      string
+	headers     http.Header
+	requestBody GetCurrenciesRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetCurrenciesRequest) Clone() GetCurrenciesRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetCurrenciesRequest) NewGetCurrenciesQueryParams() *GetCurrenciesQueryParams {
+	return &GetCurrenciesQueryParams{}
+}
+
+type GetCurrenciesQueryParams struct {
+}
+
+func (p GetCurrenciesQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetCurrenciesRequest) QueryParams() *GetCurrenciesQueryParams {
+	return r.queryParams
+}
+
+func (r GetCurrenciesRequest) NewGetCurrenciesPathParams() *GetCurrenciesPathParams {
+	return &GetCurrenciesPathParams{}
+}
+
+type GetCurrenciesPathParams struct {
+}
+
+func (p *GetCurrenciesPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetCurrenciesRequest) PathParams() *GetCurrenciesPathParams {
+	return r.pathParams
+}
+
+func (r *GetCurrenciesRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetCurrenciesRequest) Method() string {
+	return r.method
+}
+
+func (r GetCurrenciesRequest) NewGetCurrenciesRequestBody() GetCurrenciesRequestBody {
+	return GetCurrenciesRequestBody{}
+}
+
+type GetCurrenciesRequestBody struct {
+}
+
+func (r *GetCurrenciesRequest) RequestBody() *GetCurrenciesRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetCurrenciesRequest) SetRequestBody(body GetCurrenciesRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetCurrenciesRequest) NewResponseBody() *GetCurrenciesResponseBody {
+	return &GetCurrenciesResponseBody{}
+}
+
+type GetCurrenciesResponseBody Currencies
+
+func (r *GetCurrenciesRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getcurrencies", r.PathParams())
+}
+
+func (r *GetCurrenciesRequest) Do(ctx context.Context, opts ...RequestOption) (GetCurrenciesResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, nil)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type Currencies []Currency
+
+// Currency is a currency of the company's currency register
+type Currency struct {
+	Code string `json:"Code"`
+	Name string `json:"Name"`
+}
+
+// FindByCode returns the currency with the ISO 4217 code
+func (cc Currencies) FindByCode(code string) (Currency, bool) {
+	for _, c := range cc {
+		if c.Code == code {
+			return c, true
+		}
+	}
+	return Currency{}, false
+}
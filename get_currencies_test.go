@@ -0,0 +1,19 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestGetCurrencies(t *testing.T) {
+	req := client.NewGetCurrenciesRequest()
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,182 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetCurrencyRatesRequest() GetCurrencyRatesRequest {
+	r := GetCurrencyRatesRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetCurrencyRatesQueryParams()
+	r.pathParams = r.NewGetCurrencyRatesPathParams()
+	r.requestBody = r.NewGetCurrencyRatesRequestBody()
+	return r
+}
+
+type GetCurrencyRatesRequest struct {
+	client      *Client
+	queryParams *GetCurrencyRatesQueryParams
+	pathParams  *GetCurrencyRatesPathParams
+	method      string
+	headers     http.Header
+	requestBody GetCurrencyRatesRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetCurrencyRatesRequest) Clone() GetCurrencyRatesRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetCurrencyRatesRequest) NewGetCurrencyRatesQueryParams() *GetCurrencyRatesQueryParams {
+	return &GetCurrencyRatesQueryParams{}
+}
+
+type GetCurrencyRatesQueryParams struct {
+}
+
+func (p GetCurrencyRatesQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetCurrencyRatesRequest) QueryParams() *GetCurrencyRatesQueryParams {
+	return r.queryParams
+}
+
+func (r GetCurrencyRatesRequest) NewGetCurrencyRatesPathParams() *GetCurrencyRatesPathParams {
+	return &GetCurrencyRatesPathParams{}
+}
+
+type GetCurrencyRatesPathParams struct {
+}
+
+func (p *GetCurrencyRatesPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetCurrencyRatesRequest) PathParams() *GetCurrencyRatesPathParams {
+	return r.pathParams
+}
+
+func (r *GetCurrencyRatesRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetCurrencyRatesRequest) Method() string {
+	return r.method
+}
+
+func (r GetCurrencyRatesRequest) NewGetCurrencyRatesRequestBody() GetCurrencyRatesRequestBody {
+	return GetCurrencyRatesRequestBody{}
+}
+
+type GetCurrencyRatesRequestBody struct {
+	// Rates valid on this date
+	Date Date `json:"Date"`
+	// Limit the rates to this currency, all currencies when empty
+	CurrencyCode string `json:"CurrencyCode,omitempty"`
+}
+
+func (r *GetCurrencyRatesRequest) RequestBody() *GetCurrencyRatesRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetCurrencyRatesRequest) SetRequestBody(body GetCurrencyRatesRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetCurrencyRatesRequest) NewResponseBody() *GetCurrencyRatesResponseBody {
+	return &GetCurrencyRatesResponseBody{}
+}
+
+type GetCurrencyRatesResponseBody CurrencyRates
+
+func (r *GetCurrencyRatesRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getcurrencyrates", r.PathParams())
+}
+
+func (r *GetCurrencyRatesRequest) Do(ctx context.Context, opts ...RequestOption) (GetCurrencyRatesResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type CurrencyRates []CurrencyRate
+
+// CurrencyRate is the exchange rate of a currency against the company
+// currency: one unit of the company currency is Rate units of CurrencyCode
+type CurrencyRate struct {
+	CurrencyCode string  `json:"CurrencyCode"`
+	Date         Date    `json:"Date"`
+	Rate         Decimal `json:"Rate"`
+}
+
+// FindByCode returns the rate of the currency
+func (rr CurrencyRates) FindByCode(code string) (CurrencyRate, bool) {
+	for _, r := range rr {
+		if r.CurrencyCode == code {
+			return r, true
+		}
+	}
+	return CurrencyRate{}, false
+}
+
+// ToCompanyCurrency converts amount in the rate's currency to the company
+// currency, rounded to DecimalPlaces decimals. It returns zero when the rate
+// is unknown.
+func (r CurrencyRate) ToCompanyCurrency(amount Decimal) Decimal {
+	if r.Rate.IsZero() {
+		return Decimal{}
+	}
+	return amount.Div(r.Rate)
+}
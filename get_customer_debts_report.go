@@ -0,0 +1,220 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetCustomerDebtsReportRequest() GetCustomerDebtsReportRequest {
+	r := GetCustomerDebtsReportRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetCustomerDebtsReportQueryParams()
+	r.pathParams = r.NewGetCustomerDebtsReportPathParams()
+	r.requestBody = r.NewGetCustomerDebtsReportRequestBody()
+	return r
+}
+
+type GetCustomerDebtsReportRequest struct {
+	client      *Client
+	queryParams *GetCustomerDebtsReportQueryParams
+	pathParams  *GetCustomerDebtsReportPathParams
+	method      string
+	headers     http.Header
+	requestBody GetCustomerDebtsReportRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetCustomerDebtsReportRequest) Clone() GetCustomerDebtsReportRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetCustomerDebtsReportRequest) NewGetCustomerDebtsReportQueryParams() *GetCustomerDebtsReportQueryParams {
+	return &GetCustomerDebtsReportQueryParams{}
+}
+
+type GetCustomerDebtsReportQueryParams struct {
+}
+
+func (p GetCustomerDebtsReportQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetCustomerDebtsReportRequest) QueryParams() *GetCustomerDebtsReportQueryParams {
+	return r.queryParams
+}
+
+func (r GetCustomerDebtsReportRequest) NewGetCustomerDebtsReportPathParams() *GetCustomerDebtsReportPathParams {
+	return &GetCustomerDebtsReportPathParams{}
+}
+
+type GetCustomerDebtsReportPathParams struct {
+}
+
+func (p *GetCustomerDebtsReportPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetCustomerDebtsReportRequest) PathParams() *GetCustomerDebtsReportPathParams {
+	return r.pathParams
+}
+
+func (r *GetCustomerDebtsReportRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetCustomerDebtsReportRequest) Method() string {
+	return r.method
+}
+
+func (r GetCustomerDebtsReportRequest) NewGetCustomerDebtsReportRequestBody() GetCustomerDebtsReportRequestBody {
+	return GetCustomerDebtsReportRequestBody{}
+}
+
+type GetCustomerDebtsReportRequestBody struct {
+	// Limit the report to customers with this name, all customers when empty
+	CustName string `json:"CustName,omitempty"`
+	// Only include debts overdue by at least this many days
+	OverDueDays int `json:"OverDueDays,omitempty"`
+	// Debts as of this date
+	DebtDate Date `json:"DebtDate"`
+}
+
+func (r *GetCustomerDebtsReportRequest) RequestBody() *GetCustomerDebtsReportRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetCustomerDebtsReportRequest) SetRequestBody(body GetCustomerDebtsReportRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetCustomerDebtsReportRequest) NewResponseBody() *GetCustomerDebtsReportResponseBody {
+	return &GetCustomerDebtsReportResponseBody{}
+}
+
+type GetCustomerDebtsReportResponseBody DebtReportRows
+
+func (r *GetCustomerDebtsReportRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getcustdebtrep", r.PathParams())
+}
+
+func (r *GetCustomerDebtsReportRequest) Do(ctx context.Context, opts ...RequestOption) (GetCustomerDebtsReportResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type DebtReportRows []DebtReportRow
+
+// DebtReportRow is an open document in the customer or vendor debts report
+type DebtReportRow struct {
+	PartnerID    string  `json:"PartnerId"`
+	PartnerName  string  `json:"PartnerName"`
+	DocNo        string  `json:"DocNo"`
+	DocDate      Date    `json:"DocDate"`
+	DueDate      Date    `json:"DueDate"`
+	CurrencyCode string  `json:"CurrencyCode"`
+	TotalAmount  Decimal `json:"TotalAmount"`
+	PaidAmount   Decimal `json:"PaidAmount"`
+	UnPaidAmount Decimal `json:"UnPaidAmount"`
+}
+
+// OverdueDays returns the number of days the document is overdue on date,
+// zero when it isn't due yet
+func (r DebtReportRow) OverdueDays(date time.Time) int {
+	if r.DueDate.IsEmpty() || !date.After(r.DueDate.Time) {
+		return 0
+	}
+	return int(date.Sub(r.DueDate.Time).Hours() / 24)
+}
+
+// AgingBucket sums the unpaid amounts overdue between MinDays and MaxDays
+// (inclusive). MaxDays is -1 for the last, open ended, bucket.
+type AgingBucket struct {
+	MinDays int
+	MaxDays int
+	Amount  Decimal
+}
+
+// Aging returns the unpaid amounts on date in the buckets not due, 1-30,
+// 31-60, 61-90 and more than 90 days overdue
+func (rr DebtReportRows) Aging(date time.Time) []AgingBucket {
+	buckets := []AgingBucket{
+		{MinDays: 0, MaxDays: 0},
+		{MinDays: 1, MaxDays: 30},
+		{MinDays: 31, MaxDays: 60},
+		{MinDays: 61, MaxDays: 90},
+		{MinDays: 91, MaxDays: -1},
+	}
+
+	for _, r := range rr {
+		days := r.OverdueDays(date)
+		for i, b := range buckets {
+			if days >= b.MinDays && (b.MaxDays == -1 || days <= b.MaxDays) {
+				buckets[i].Amount = buckets[i].Amount.Add(r.UnPaidAmount)
+				break
+			}
+		}
+	}
+
+	return buckets
+}
+
+// UnpaidByCurrency sums the unpaid amounts per currency code
+func (rr DebtReportRows) UnpaidByCurrency() map[string]Decimal {
+	totals := map[string]Decimal{}
+	for _, r := range rr {
+		totals[r.CurrencyCode] = totals[r.CurrencyCode].Add(r.UnPaidAmount)
+	}
+	return totals
+}
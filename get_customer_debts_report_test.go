@@ -0,0 +1,40 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestGetCustomerDebtsReport(t *testing.T) {
+	req := client.NewGetCustomerDebtsReportRequest()
+	req.RequestBody().DebtDate = aktiva.Date{time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)}
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
+
+func TestDebtReportAging(t *testing.T) {
+	date := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	rows := aktiva.DebtReportRows{
+		{DueDate: aktiva.Date{Time: date.AddDate(0, 0, 5)}, UnPaidAmount: aktiva.NewDecimal(100, 0)},
+		{DueDate: aktiva.Date{Time: date.AddDate(0, 0, -10)}, UnPaidAmount: aktiva.NewDecimal(50, 0)},
+		{DueDate: aktiva.Date{Time: date.AddDate(0, 0, -45)}, UnPaidAmount: aktiva.NewDecimal(25, 0)},
+		{DueDate: aktiva.Date{Time: date.AddDate(0, 0, -120)}, UnPaidAmount: aktiva.NewDecimal(10, 0)},
+	}
+
+	expected := []int64{100, 50, 25, 0, 10}
+	for i, b := range rows.Aging(date) {
+		if !b.Amount.Equal(aktiva.NewDecimal(expected[i], 0)) {
+			t.Errorf("bucket %d-%d: expected %d, got %s", b.MinDays, b.MaxDays, expected[i], b.Amount)
+		}
+	}
+}
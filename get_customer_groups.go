@@ -0,0 +1,156 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetCustomerGroupsRequest() GetCustomerGroupsRequest {
+	r := GetCustomerGroupsRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetCustomerGroupsQueryParams()
+	r.pathParams = r.NewGetCustomerGroupsPathParams()
+	r.requestBody = r.NewGetCustomerGroupsRequestBody()
+	return r
+}
+
+type GetCustomerGroupsRequest struct {
+	client      *Client
+	queryParams *GetCustomerGroupsQueryParams
+	pathParams  *GetCustomerGroupsPathParams
+	method      string
+	headers     http.Header
+	requestBody GetCustomerGroupsRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetCustomerGroupsRequest) Clone() GetCustomerGroupsRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetCustomerGroupsRequest) NewGetCustomerGroupsQueryParams() *GetCustomerGroupsQueryParams {
+	return &GetCustomerGroupsQueryParams{}
+}
+
+type GetCustomerGroupsQueryParams struct {
+}
+
+func (p GetCustomerGroupsQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetCustomerGroupsRequest) QueryParams() *GetCustomerGroupsQueryParams {
+	return r.queryParams
+}
+
+func (r GetCustomerGroupsRequest) NewGetCustomerGroupsPathParams() *GetCustomerGroupsPathParams {
+	return &GetCustomerGroupsPathParams{}
+}
+
+type GetCustomerGroupsPathParams struct {
+}
+
+func (p *GetCustomerGroupsPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetCustomerGroupsRequest) PathParams() *GetCustomerGroupsPathParams {
+	return r.pathParams
+}
+
+func (r *GetCustomerGroupsRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetCustomerGroupsRequest) Method() string {
+	return r.method
+}
+
+func (r GetCustomerGroupsRequest) NewGetCustomerGroupsRequestBody() GetCustomerGroupsRequestBody {
+	return GetCustomerGroupsRequestBody{}
+}
+
+type GetCustomerGroupsRequestBody struct {
+}
+
+func (r *GetCustomerGroupsRequest) RequestBody() *GetCustomerGroupsRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetCustomerGroupsRequest) SetRequestBody(body GetCustomerGroupsRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetCustomerGroupsRequest) NewResponseBody() *GetCustomerGroupsResponseBody {
+	return &GetCustomerGroupsResponseBody{}
+}
+
+type GetCustomerGroupsResponseBody CustomerGroups
+
+func (r *GetCustomerGroupsRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getcustomergroups", r.PathParams())
+}
+
+func (r *GetCustomerGroupsRequest) Do(ctx context.Context, opts ...RequestOption) (GetCustomerGroupsResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, nil)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type CustomerGroups []CustomerGroup
+
+type CustomerGroup struct {
+	ID   string `json:"Id"`
+	Code string `json:"Code"`
+	Name string `json:"Name"`
+}
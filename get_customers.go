@@ -1,9 +1,12 @@
 package aktiva
 
 import (
+	"context"
 	"net/http"
 	"net/url"
+	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/omniboost/go-merit-aktiva/utils"
 )
 
@@ -29,6 +32,22 @@ type GetCustomersRequest struct {
 	requestBody GetCustomersRequestBody
 }
 
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetCustomersRequest) Clone() GetCustomersRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
 func (r GetCustomersRequest) NewGetCustomersQueryParams() *GetCustomersQueryParams {
 	return &GetCustomersQueryParams{}
 }
@@ -36,7 +55,7 @@ func (r GetCustomersRequest) NewGetCustomersQueryParams() *GetCustomersQueryPara
 type GetCustomersQueryParams struct{}
 
 func (p GetCustomersQueryParams) ToURLValues() (url.Values, error) {
-	encoder := utils.NewSchemaEncoder()
+	encoder := NewSchemaEncoder()
 	params := url.Values{}
 
 	err := encoder.Encode(p, params)
@@ -78,8 +97,7 @@ func (r GetCustomersRequest) NewGetCustomersRequestBody() GetCustomersRequestBod
 	return GetCustomersRequestBody{}
 }
 
-type GetCustomersRequestBody struct {
-}
+type GetCustomersRequestBody CustomersFilter
 
 func (r *GetCustomersRequest) RequestBody() *GetCustomersRequestBody {
 	return &r.requestBody
@@ -89,25 +107,49 @@ func (r *GetCustomersRequest) SetRequestBody(body GetCustomersRequestBody) {
 	r.requestBody = body
 }
 
+// SetChangedSince limits the results to records changed after t, for
+// incremental syncs. t is sent in the client's location. The zero time
+// removes the limit.
+func (r *GetCustomersRequest) SetChangedSince(t time.Time) {
+	if t.IsZero() {
+		r.requestBody.ChangedDate = nil
+		return
+	}
+	changed := r.client.DateTime(t)
+	r.requestBody.ChangedDate = &changed
+}
+
 func (r *GetCustomersRequest) NewResponseBody() *GetCustomersResponseBody {
 	return &GetCustomersResponseBody{}
 }
 
 type GetCustomersResponseBody Customers
 
-func (r *GetCustomersRequest) URL() url.URL {
+func (r *GetCustomersRequest) URL() (url.URL, error) {
 	return r.client.GetEndpointURL("getcustomers", r.PathParams())
 }
 
-func (r *GetCustomersRequest) Do() (GetCustomersResponseBody, error) {
+func (r *GetCustomersRequest) Do(ctx context.Context, opts ...RequestOption) (GetCustomersResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
 	// Create http request
-	req, err := r.client.NewRequest(nil, r.Method(), r.URL(), nil)
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
 
 	// Process query parameters
-	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
@@ -117,6 +159,15 @@ func (r *GetCustomersRequest) Do() (GetCustomersResponseBody, error) {
 	return *responseBody, err
 }
 
+type CustomersFilter struct {
+	ID       *uuid.UUID `json:"Id,omitempty"`
+	Name     string     `json:"Name,omitempty"`
+	RegNo    string     `json:"RegNo,omitempty"`
+	VatRegNo string     `json:"VatRegNo,omitempty"`
+	// Only return records changed after this moment, see SetChangedSince
+	ChangedDate *DateTime `json:"ChangedDate,omitempty"`
+}
+
 type Customers []Customer
 
 type Customer struct {
@@ -136,7 +187,7 @@ type Customer struct {
 	Email             string      `json:"Email"`
 	HomePage          string      `json:"HomePage"`
 	PaymentDeadLine   int         `json:"PaymentDeadLine"`
-	OverdueCharge     float64     `json:"OverdueCharge"`
+	OverdueCharge     Decimal     `json:"OverdueCharge"`
 	CurrencyCode      string      `json:"CurrencyCode"`
 	CustomerGroupName string      `json:"CustomerGroupName"`
 	VatRegNo          string      `json:"VatRegNo"`
@@ -1,14 +1,18 @@
 package aktiva_test
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
 )
 
 func TestGetCustomers(t *testing.T) {
 	req := client.NewGetCustomersRequest()
-	resp, err := req.Do()
+	resp, err := req.Do(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -16,3 +20,22 @@ func TestGetCustomers(t *testing.T) {
 	b, _ := json.MarshalIndent(resp, "", "  ")
 	log.Println(string(b))
 }
+
+func TestGetCustomersChangedSince(t *testing.T) {
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetLocation(time.FixedZone("EET", 2*60*60))
+
+	req := c.NewGetCustomersRequest()
+	req.SetChangedSince(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+
+	b, _ := json.Marshal(req.RequestBody())
+	if string(b) != `{"ChangedDate":"20200102050405"}` {
+		t.Errorf("unexpected body %s", b)
+	}
+
+	req.SetChangedSince(time.Time{})
+	b, _ = json.Marshal(req.RequestBody())
+	if string(b) != `{}` {
+		t.Errorf("unexpected body %s", b)
+	}
+}
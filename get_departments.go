@@ -0,0 +1,158 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetDepartmentsRequest() GetDepartmentsRequest {
+	r := GetDepartmentsRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetDepartmentsQueryParams()
+	r.pathParams = r.NewGetDepartmentsPathParams()
+	r.requestBody = r.NewGetDepartmentsRequestBody()
+	return r
+}
+
+type GetDepartmentsRequest struct {
+	client      *Client
+	queryParams *GetDepartmentsQueryParams
+	pathParams  *GetDepartmentsPathParams
+	method      string
+	headers     http.Header
+	requestBody GetDepartmentsRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetDepartmentsRequest) Clone() GetDepartmentsRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetDepartmentsRequest) NewGetDepartmentsQueryParams() *GetDepartmentsQueryParams {
+	return &GetDepartmentsQueryParams{}
+}
+
+type GetDepartmentsQueryParams struct {
+}
+
+func (p GetDepartmentsQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetDepartmentsRequest) QueryParams() *GetDepartmentsQueryParams {
+	return r.queryParams
+}
+
+func (r GetDepartmentsRequest) NewGetDepartmentsPathParams() *GetDepartmentsPathParams {
+	return &GetDepartmentsPathParams{}
+}
+
+type GetDepartmentsPathParams struct {
+}
+
+func (p *GetDepartmentsPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetDepartmentsRequest) PathParams() *GetDepartmentsPathParams {
+	return r.pathParams
+}
+
+func (r *GetDepartmentsRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetDepartmentsRequest) Method() string {
+	return r.method
+}
+
+func (r GetDepartmentsRequest) NewGetDepartmentsRequestBody() GetDepartmentsRequestBody {
+	return GetDepartmentsRequestBody{}
+}
+
+type GetDepartmentsRequestBody struct {
+}
+
+func (r *GetDepartmentsRequest) RequestBody() *GetDepartmentsRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetDepartmentsRequest) SetRequestBody(body GetDepartmentsRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetDepartmentsRequest) NewResponseBody() *GetDepartmentsResponseBody {
+	return &GetDepartmentsResponseBody{}
+}
+
+type GetDepartmentsResponseBody Departments
+
+func (r *GetDepartmentsRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getdepartments", r.PathParams())
+}
+
+func (r *GetDepartmentsRequest) Do(ctx context.Context, opts ...RequestOption) (GetDepartmentsResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type Departments []Department
+
+// Department (üksus) is referenced by DepartmentCode on documents and rows
+type Department struct {
+	ID        string `json:"Id"`
+	Code      string `json:"Code"`
+	Name      string `json:"Name"`
+	NonActive bool   `json:"NonActive"`
+}
@@ -0,0 +1,161 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetDimensionValuesRequest() GetDimensionValuesRequest {
+	r := GetDimensionValuesRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetDimensionValuesQueryParams()
+	r.pathParams = r.NewGetDimensionValuesPathParams()
+	r.requestBody = r.NewGetDimensionValuesRequestBody()
+	return r
+}
+
+type GetDimensionValuesRequest struct {
+	client      *Client
+	queryParams *GetDimensionValuesQueryParams
+	pathParams  *GetDimensionValuesPathParams
+	method      string
+	headers     http.Header
+	requestBody GetDimensionValuesRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetDimensionValuesRequest) Clone() GetDimensionValuesRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetDimensionValuesRequest) NewGetDimensionValuesQueryParams() *GetDimensionValuesQueryParams {
+	return &GetDimensionValuesQueryParams{}
+}
+
+type GetDimensionValuesQueryParams struct {
+}
+
+func (p GetDimensionValuesQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetDimensionValuesRequest) QueryParams() *GetDimensionValuesQueryParams {
+	return r.queryParams
+}
+
+func (r GetDimensionValuesRequest) NewGetDimensionValuesPathParams() *GetDimensionValuesPathParams {
+	return &GetDimensionValuesPathParams{}
+}
+
+type GetDimensionValuesPathParams struct {
+}
+
+func (p *GetDimensionValuesPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetDimensionValuesRequest) PathParams() *GetDimensionValuesPathParams {
+	return r.pathParams
+}
+
+func (r *GetDimensionValuesRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetDimensionValuesRequest) Method() string {
+	return r.method
+}
+
+func (r GetDimensionValuesRequest) NewGetDimensionValuesRequestBody() GetDimensionValuesRequestBody {
+	return GetDimensionValuesRequestBody{}
+}
+
+type GetDimensionValuesRequestBody struct {
+	// Only return values of this dimension, all dimensions when zero
+	DimID int `json:"DimId,omitempty"`
+}
+
+func (r *GetDimensionValuesRequest) RequestBody() *GetDimensionValuesRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetDimensionValuesRequest) SetRequestBody(body GetDimensionValuesRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetDimensionValuesRequest) NewResponseBody() *GetDimensionValuesResponseBody {
+	return &GetDimensionValuesResponseBody{}
+}
+
+type GetDimensionValuesResponseBody DimensionValues
+
+func (r *GetDimensionValuesRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getdimvalues", r.PathParams())
+}
+
+func (r *GetDimensionValuesRequest) Do(ctx context.Context, opts ...RequestOption) (GetDimensionValuesResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type DimensionValues []DimensionValue
+
+type DimensionValue struct {
+	ID        string `json:"Id"`
+	DimID     int    `json:"DimId"`
+	Code      string `json:"Code"`
+	Name      string `json:"Name"`
+	NonActive bool   `json:"NonActive"`
+	EndDate   string `json:"EndDate"`
+}
@@ -0,0 +1,169 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetDimensionsRequest() GetDimensionsRequest {
+	r := GetDimensionsRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetDimensionsQueryParams()
+	r.pathParams = r.NewGetDimensionsPathParams()
+	r.requestBody = r.NewGetDimensionsRequestBody()
+	return r
+}
+
+type GetDimensionsRequest struct {
+	client      *Client
+	queryParams *GetDimensionsQueryParams
+	pathParams  *GetDimensionsPathParams
+	method      string
+	headers     http.Header
+	requestBody GetDimensionsRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetDimensionsRequest) Clone() GetDimensionsRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetDimensionsRequest) NewGetDimensionsQueryParams() *GetDimensionsQueryParams {
+	return &GetDimensionsQueryParams{}
+}
+
+type GetDimensionsQueryParams struct {
+}
+
+func (p GetDimensionsQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetDimensionsRequest) QueryParams() *GetDimensionsQueryParams {
+	return r.queryParams
+}
+
+func (r GetDimensionsRequest) NewGetDimensionsPathParams() *GetDimensionsPathParams {
+	return &GetDimensionsPathParams{}
+}
+
+type GetDimensionsPathParams struct {
+}
+
+func (p *GetDimensionsPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetDimensionsRequest) PathParams() *GetDimensionsPathParams {
+	return r.pathParams
+}
+
+func (r *GetDimensionsRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetDimensionsRequest) Method() string {
+	return r.method
+}
+
+func (r GetDimensionsRequest) NewGetDimensionsRequestBody() GetDimensionsRequestBody {
+	return GetDimensionsRequestBody{}
+}
+
+type GetDimensionsRequestBody struct {
+}
+
+func (r *GetDimensionsRequest) RequestBody() *GetDimensionsRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetDimensionsRequest) SetRequestBody(body GetDimensionsRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetDimensionsRequest) NewResponseBody() *GetDimensionsResponseBody {
+	return &GetDimensionsResponseBody{}
+}
+
+type GetDimensionsResponseBody Dimensions
+
+func (r *GetDimensionsRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getdimensions", r.PathParams())
+}
+
+func (r *GetDimensionsRequest) Do(ctx context.Context, opts ...RequestOption) (GetDimensionsResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type Dimensions []Dimension
+
+// Dimension is a dimension type, e.g. "Region" or "Sales channel"
+type Dimension struct {
+	DimID     int    `json:"DimId"`
+	DimName   string `json:"DimName"`
+	NonActive bool   `json:"NonActive"`
+}
+
+type RowDimensions []RowDimension
+
+// RowDimension tags an invoice or GL row with a dimension value
+type RowDimension struct {
+	// Required. Use getdimensions endpoint to detect the id needed
+	DimID int `json:"DimId"`
+	// Use getdimvalues endpoint to detect the guid needed
+	DimValueID string `json:"DimValueId,omitempty"`
+	// Required when DimValueId is empty
+	DimCode string `json:"DimCode,omitempty"`
+}
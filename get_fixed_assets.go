@@ -0,0 +1,166 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetFixedAssetsRequest() GetFixedAssetsRequest {
+	r := GetFixedAssetsRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetFixedAssetsQueryParams()
+	r.pathParams = r.NewGetFixedAssetsPathParams()
+	r.requestBody = r.NewGetFixedAssetsRequestBody()
+	return r
+}
+
+type GetFixedAssetsRequest struct {
+	client      *Client
+	queryParams *GetFixedAssetsQueryParams
+	pathParams  *GetFixedAssetsPathParams
+	method      string
+	headers     http.Header
+	requestBody GetFixedAssetsRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetFixedAssetsRequest) Clone() GetFixedAssetsRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetFixedAssetsRequest) NewGetFixedAssetsQueryParams() *GetFixedAssetsQueryParams {
+	return &GetFixedAssetsQueryParams{}
+}
+
+type GetFixedAssetsQueryParams struct {
+}
+
+func (p GetFixedAssetsQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetFixedAssetsRequest) QueryParams() *GetFixedAssetsQueryParams {
+	return r.queryParams
+}
+
+func (r GetFixedAssetsRequest) NewGetFixedAssetsPathParams() *GetFixedAssetsPathParams {
+	return &GetFixedAssetsPathParams{}
+}
+
+type GetFixedAssetsPathParams struct {
+}
+
+func (p *GetFixedAssetsPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetFixedAssetsRequest) PathParams() *GetFixedAssetsPathParams {
+	return r.pathParams
+}
+
+func (r *GetFixedAssetsRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetFixedAssetsRequest) Method() string {
+	return r.method
+}
+
+func (r GetFixedAssetsRequest) NewGetFixedAssetsRequestBody() GetFixedAssetsRequestBody {
+	return GetFixedAssetsRequestBody{}
+}
+
+type GetFixedAssetsRequestBody struct {
+}
+
+func (r *GetFixedAssetsRequest) RequestBody() *GetFixedAssetsRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetFixedAssetsRequest) SetRequestBody(body GetFixedAssetsRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetFixedAssetsRequest) NewResponseBody() *GetFixedAssetsResponseBody {
+	return &GetFixedAssetsResponseBody{}
+}
+
+type GetFixedAssetsResponseBody FixedAssets
+
+func (r *GetFixedAssetsRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getfixedassets", r.PathParams())
+}
+
+func (r *GetFixedAssetsRequest) Do(ctx context.Context, opts ...RequestOption) (GetFixedAssetsResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type FixedAssets []FixedAsset
+
+type FixedAsset struct {
+	ID                      string  `json:"Id"`
+	Code                    string  `json:"Code"`
+	Name                    string  `json:"Name"`
+	GroupName               string  `json:"GroupName"`
+	AcquisitionDate         string  `json:"AcquisitionDate"`
+	AcquisitionCost         Decimal `json:"AcquisitionCost"`
+	AccumulatedDepreciation Decimal `json:"AccumulatedDepreciation"`
+	ResidualValue           Decimal `json:"ResidualValue"`
+	DepreciationPct         Decimal `json:"DepreciationPct"`
+	LocationName            string  `json:"LocationName"`
+	ResponsiblePerson       string  `json:"ResponsiblePerson"`
+	DepartmentCode          string  `json:"DepartmentCode"`
+	NonActive               bool    `json:"NonActive"`
+}
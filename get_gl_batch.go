@@ -1,6 +1,7 @@
 package aktiva
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 
@@ -30,6 +31,22 @@ type GetGLBatchRequest struct {
 	requestBody GetGLBatchRequestBody
 }
 
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetGLBatchRequest) Clone() GetGLBatchRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
 func (r GetGLBatchRequest) NewGetGLBatchQueryParams() *GetGLBatchQueryParams {
 	return &GetGLBatchQueryParams{}
 }
@@ -38,7 +55,7 @@ type GetGLBatchQueryParams struct {
 }
 
 func (p GetGLBatchQueryParams) ToURLValues() (url.Values, error) {
-	encoder := utils.NewSchemaEncoder()
+	encoder := NewSchemaEncoder()
 	params := url.Values{}
 
 	err := encoder.Encode(p, params)
@@ -104,8 +121,8 @@ type GetGLBatchResponseBody struct {
 		Document     interface{} `json:"Document"`
 		BatchDate    string      `json:"BatchDate"`
 		CurrencyCode string      `json:"CurrencyCode"`
-		CurrencyRate float64     `json:"CurrencyRate"`
-		TotalAmount  float64     `json:"TotalAmount"`
+		CurrencyRate Decimal     `json:"CurrencyRate"`
+		TotalAmount  Decimal     `json:"TotalAmount"`
 		PriceInclVat int         `json:"PriceInclVat"`
 	} `json:"Header"`
 	Lines []struct {
@@ -113,27 +130,39 @@ type GetGLBatchResponseBody struct {
 		Memo           string      `json:"Memo"`
 		DepartmentCode interface{} `json:"DepartmentCode"`
 		TaxName        string      `json:"TaxName"`
-		DebitAmount    float64     `json:"DebitAmount"`
-		DebitCurrency  float64     `json:"DebitCurrency"`
-		CreditAmount   float64     `json:"CreditAmount"`
-		CreditCurrency float64     `json:"CreditCurrency"`
+		DebitAmount    Decimal     `json:"DebitAmount"`
+		DebitCurrency  Decimal     `json:"DebitCurrency"`
+		CreditAmount   Decimal     `json:"CreditAmount"`
+		CreditCurrency Decimal     `json:"CreditCurrency"`
 		TypeID         int         `json:"TypeId"`
 	} `json:"Lines"`
 }
 
-func (r *GetGLBatchRequest) URL() url.URL {
+func (r *GetGLBatchRequest) URL() (url.URL, error) {
 	return r.client.GetEndpointURL("getglbatch", r.PathParams())
 }
 
-func (r *GetGLBatchRequest) Do() (GetGLBatchResponseBody, error) {
+func (r *GetGLBatchRequest) Do(ctx context.Context, opts ...RequestOption) (GetGLBatchResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
 	// Create http request
-	req, err := r.client.NewRequest(nil, r.Method(), r.URL(), r.RequestBody())
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
 
 	// Process query parameters
-	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
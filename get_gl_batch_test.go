@@ -1,6 +1,7 @@
 package aktiva_test
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"testing"
@@ -11,7 +12,7 @@ import (
 func TestGetGLBatch(t *testing.T) {
 	req := client.NewGetGLBatchRequest()
 	req.RequestBody().ID = uuid.FromStringOrNil("17a6d491-3ed0-4a5e-ab28-11e18359929f")
-	resp, err := req.Do()
+	resp, err := req.Do(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
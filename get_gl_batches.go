@@ -1,6 +1,7 @@
 package aktiva
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 
@@ -29,6 +30,22 @@ type GetGLBatchesRequest struct {
 	requestBody GetGLBatchesRequestBody
 }
 
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetGLBatchesRequest) Clone() GetGLBatchesRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
 func (r GetGLBatchesRequest) NewGetGLBatchesQueryParams() *GetGLBatchesQueryParams {
 	return &GetGLBatchesQueryParams{}
 }
@@ -37,7 +54,7 @@ type GetGLBatchesQueryParams struct {
 }
 
 func (p GetGLBatchesQueryParams) ToURLValues() (url.Values, error) {
-	encoder := utils.NewSchemaEncoder()
+	encoder := NewSchemaEncoder()
 	params := url.Values{}
 
 	err := encoder.Encode(p, params)
@@ -103,24 +120,36 @@ type GetGLBatchesResponseBody []struct {
 	Document     interface{} `json:"Document"`
 	BatchDate    string      `json:"BatchDate"`
 	CurrencyCode string      `json:"CurrencyCode"`
-	CurrencyRate float64     `json:"CurrencyRate"`
-	TotalAmount  float64     `json:"TotalAmount"`
+	CurrencyRate Decimal     `json:"CurrencyRate"`
+	TotalAmount  Decimal     `json:"TotalAmount"`
 	PriceInclVat int         `json:"PriceInclVat"`
 }
 
-func (r *GetGLBatchesRequest) URL() url.URL {
+func (r *GetGLBatchesRequest) URL() (url.URL, error) {
 	return r.client.GetEndpointURL("getglbatches", r.PathParams())
 }
 
-func (r *GetGLBatchesRequest) Do() (GetGLBatchesResponseBody, error) {
+func (r *GetGLBatchesRequest) Do(ctx context.Context, opts ...RequestOption) (GetGLBatchesResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
 	// Create http request
-	req, err := r.client.NewRequest(nil, r.Method(), r.URL(), r.RequestBody())
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
 
 	// Process query parameters
-	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
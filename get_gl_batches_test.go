@@ -1,6 +1,7 @@
 package aktiva_test
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"testing"
@@ -13,7 +14,7 @@ func TestGetGLBatches(t *testing.T) {
 	req := client.NewGetGLBatchesRequest()
 	req.RequestBody().PeriodStart = aktiva.Date{time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC)}
 	req.RequestBody().PeriodEnd = aktiva.Date{time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)}
-	resp, err := req.Do()
+	resp, err := req.Do(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
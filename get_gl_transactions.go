@@ -0,0 +1,202 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetGLTransactionsRequest() GetGLTransactionsRequest {
+	r := GetGLTransactionsRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetGLTransactionsQueryParams()
+	r.pathParams = r.NewGetGLTransactionsPathParams()
+	r.requestBody = r.NewGetGLTransactionsRequestBody()
+	return r
+}
+
+type GetGLTransactionsRequest struct {
+	client      *Client
+	queryParams *GetGLTransactionsQueryParams
+	pathParams  *GetGLTransactionsPathParams
+	method      string
+	headers     http.Header
+	requestBody GetGLTransactionsRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetGLTransactionsRequest) Clone() GetGLTransactionsRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetGLTransactionsRequest) NewGetGLTransactionsQueryParams() *GetGLTransactionsQueryParams {
+	return &GetGLTransactionsQueryParams{}
+}
+
+type GetGLTransactionsQueryParams struct {
+}
+
+func (p GetGLTransactionsQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetGLTransactionsRequest) QueryParams() *GetGLTransactionsQueryParams {
+	return r.queryParams
+}
+
+func (r GetGLTransactionsRequest) NewGetGLTransactionsPathParams() *GetGLTransactionsPathParams {
+	return &GetGLTransactionsPathParams{}
+}
+
+type GetGLTransactionsPathParams struct {
+}
+
+func (p *GetGLTransactionsPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetGLTransactionsRequest) PathParams() *GetGLTransactionsPathParams {
+	return r.pathParams
+}
+
+func (r *GetGLTransactionsRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetGLTransactionsRequest) Method() string {
+	return r.method
+}
+
+func (r GetGLTransactionsRequest) NewGetGLTransactionsRequestBody() GetGLTransactionsRequestBody {
+	return GetGLTransactionsRequestBody{}
+}
+
+type GetGLTransactionsRequestBody struct {
+	PeriodStart Date
+	PeriodEnd   Date
+}
+
+func (r *GetGLTransactionsRequest) RequestBody() *GetGLTransactionsRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetGLTransactionsRequest) SetRequestBody(body GetGLTransactionsRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetGLTransactionsRequest) NewResponseBody() *GetGLTransactionsResponseBody {
+	return &GetGLTransactionsResponseBody{}
+}
+
+type GetGLTransactionsResponseBody GLTransactions
+
+func (r *GetGLTransactionsRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("gettransactions", r.PathParams())
+}
+
+func (r *GetGLTransactionsRequest) Do(ctx context.Context, opts ...RequestOption) (GetGLTransactionsResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type GLTransactions []GLTransaction
+
+type GLTransaction struct {
+	GLBID        string  `json:"GLBId"`
+	BatchCode    string  `json:"BatchCode"`
+	No           int     `json:"No"`
+	BatchDate    string  `json:"BatchDate"`
+	CurrencyCode string  `json:"CurrencyCode"`
+	CurrencyRate Decimal `json:"CurrencyRate"`
+	// Reference to the source document (invoice, payment, ...) of the
+	// transaction
+	DocumentID   string             `json:"DocumentId"`
+	DocumentNo   string             `json:"DocumentNo"`
+	DocumentType string             `json:"DocumentType"`
+	Lines        GLTransactionLines `json:"Lines"`
+}
+
+type GLTransactionLines []GLTransactionLine
+
+type GLTransactionLine struct {
+	AccountCode    string        `json:"AccountCode"`
+	AccountName    string        `json:"AccountName"`
+	Memo           string        `json:"Memo"`
+	DepartmentCode string        `json:"DepartmentCode"`
+	ProjectCode    string        `json:"ProjectCode"`
+	CostCenterCode string        `json:"CostCenterCode"`
+	TaxName        string        `json:"TaxName"`
+	DebitAmount    Decimal       `json:"DebitAmount"`
+	DebitCurrency  Decimal       `json:"DebitCurrency"`
+	CreditAmount   Decimal       `json:"CreditAmount"`
+	CreditCurrency Decimal       `json:"CreditCurrency"`
+	Dimensions     RowDimensions `json:"Dimensions"`
+}
+
+// Debit returns the sum of the debit amounts of all lines
+func (ll GLTransactionLines) Debit() Decimal {
+	total := Decimal{}
+	for _, l := range ll {
+		total = total.Add(l.DebitAmount)
+	}
+	return total
+}
+
+// Credit returns the sum of the credit amounts of all lines
+func (ll GLTransactionLines) Credit() Decimal {
+	total := Decimal{}
+	for _, l := range ll {
+		total = total.Add(l.CreditAmount)
+	}
+	return total
+}
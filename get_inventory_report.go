@@ -0,0 +1,184 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetInventoryReportRequest() GetInventoryReportRequest {
+	r := GetInventoryReportRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetInventoryReportQueryParams()
+	r.pathParams = r.NewGetInventoryReportPathParams()
+	r.requestBody = r.NewGetInventoryReportRequestBody()
+	return r
+}
+
+type GetInventoryReportRequest struct {
+	client      *Client
+	queryParams *GetInventoryReportQueryParams
+	pathParams  *GetInventoryReportPathParams
+	method      string
+	headers     http.Header
+	requestBody GetInventoryReportRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetInventoryReportRequest) Clone() GetInventoryReportRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetInventoryReportRequest) NewGetInventoryReportQueryParams() *GetInventoryReportQueryParams {
+	return &GetInventoryReportQueryParams{}
+}
+
+type GetInventoryReportQueryParams struct {
+}
+
+func (p GetInventoryReportQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetInventoryReportRequest) QueryParams() *GetInventoryReportQueryParams {
+	return r.queryParams
+}
+
+func (r GetInventoryReportRequest) NewGetInventoryReportPathParams() *GetInventoryReportPathParams {
+	return &GetInventoryReportPathParams{}
+}
+
+type GetInventoryReportPathParams struct {
+}
+
+func (p *GetInventoryReportPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetInventoryReportRequest) PathParams() *GetInventoryReportPathParams {
+	return r.pathParams
+}
+
+func (r *GetInventoryReportRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetInventoryReportRequest) Method() string {
+	return r.method
+}
+
+func (r GetInventoryReportRequest) NewGetInventoryReportRequestBody() GetInventoryReportRequestBody {
+	return GetInventoryReportRequestBody{}
+}
+
+type GetInventoryReportRequestBody struct {
+	// Stock quantities as of this date
+	RepDate Date `json:"RepDate"`
+	// Limit the report to one location, all locations when empty
+	LocationCode string `json:"LocationCode,omitempty"`
+	// Limit the report to one item, all items when empty
+	ItemCode string `json:"ItemCode,omitempty"`
+	// Include items without stock
+	ShowZero bool `json:"ShowZero"`
+}
+
+func (r *GetInventoryReportRequest) RequestBody() *GetInventoryReportRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetInventoryReportRequest) SetRequestBody(body GetInventoryReportRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetInventoryReportRequest) NewResponseBody() *GetInventoryReportResponseBody {
+	return &GetInventoryReportResponseBody{}
+}
+
+type GetInventoryReportResponseBody InventoryReportRows
+
+func (r *GetInventoryReportRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getinventoryreport", r.PathParams())
+}
+
+func (r *GetInventoryReportRequest) Do(ctx context.Context, opts ...RequestOption) (GetInventoryReportResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type InventoryReportRows []InventoryReportRow
+
+type InventoryReportRow struct {
+	ItemCode          string  `json:"ItemCode"`
+	ItemName          string  `json:"ItemName"`
+	LocationCode      string  `json:"LocationCode"`
+	LocationName      string  `json:"LocationName"`
+	UnitofMeasureName string  `json:"UnitofMeasureName"`
+	Quantity          Decimal `json:"Quantity"`
+	Amount            Decimal `json:"Amount"`
+}
+
+// Quantity returns the stock quantity of an item, summed over all locations
+// when locationCode is empty
+func (rr InventoryReportRows) Quantity(itemCode, locationCode string) Decimal {
+	qty := Decimal{}
+	for _, r := range rr {
+		if r.ItemCode != itemCode {
+			continue
+		}
+		if locationCode != "" && r.LocationCode != locationCode {
+			continue
+		}
+		qty = qty.Add(r.Quantity)
+	}
+	return qty
+}
@@ -0,0 +1,202 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetInvoiceRequest() GetInvoiceRequest {
+	r := GetInvoiceRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetInvoiceQueryParams()
+	r.pathParams = r.NewGetInvoicePathParams()
+	r.requestBody = r.NewGetInvoiceRequestBody()
+	return r
+}
+
+type GetInvoiceRequest struct {
+	client      *Client
+	queryParams *GetInvoiceQueryParams
+	pathParams  *GetInvoicePathParams
+	method      string
+	headers     http.Header
+	requestBody GetInvoiceRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetInvoiceRequest) Clone() GetInvoiceRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetInvoiceRequest) NewGetInvoiceQueryParams() *GetInvoiceQueryParams {
+	return &GetInvoiceQueryParams{}
+}
+
+type GetInvoiceQueryParams struct {
+}
+
+func (p GetInvoiceQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetInvoiceRequest) QueryParams() *GetInvoiceQueryParams {
+	return r.queryParams
+}
+
+func (r GetInvoiceRequest) NewGetInvoicePathParams() *GetInvoicePathParams {
+	return &GetInvoicePathParams{}
+}
+
+type GetInvoicePathParams struct {
+}
+
+func (p *GetInvoicePathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetInvoiceRequest) PathParams() *GetInvoicePathParams {
+	return r.pathParams
+}
+
+func (r *GetInvoiceRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetInvoiceRequest) Method() string {
+	return r.method
+}
+
+func (r GetInvoiceRequest) NewGetInvoiceRequestBody() GetInvoiceRequestBody {
+	return GetInvoiceRequestBody{}
+}
+
+type GetInvoiceRequestBody struct {
+	ID uuid.UUID `json:"Id"`
+	// Include the attached file, usually the invoice PDF
+	AddAttachment bool `json:"AddAttachment,omitempty"`
+}
+
+func (r *GetInvoiceRequest) RequestBody() *GetInvoiceRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetInvoiceRequest) SetRequestBody(body GetInvoiceRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetInvoiceRequest) NewResponseBody() *GetInvoiceResponseBody {
+	return &GetInvoiceResponseBody{}
+}
+
+// GetInvoiceResponseBody is a sales invoice with its rows and payments
+type GetInvoiceResponseBody struct {
+	Header struct {
+		SIHID           string  `json:"SIHId"`
+		InvoiceNo       string  `json:"InvoiceNo"`
+		CustomerID      string  `json:"CustomerId"`
+		CustomerName    string  `json:"CustomerName"`
+		DocumentDate    Date    `json:"DocumentDate"`
+		TransactionDate Date    `json:"TransactionDate"`
+		DueDate         Date    `json:"DueDate"`
+		CurrencyCode    string  `json:"CurrencyCode"`
+		CurrencyRate    Decimal `json:"CurrencyRate"`
+		TaxAmount       Decimal `json:"TaxAmount"`
+		TotalAmount     Decimal `json:"TotalAmount"`
+		RoundingAmount  Decimal `json:"RoundingAmount"`
+		HComment        string  `json:"HComment"`
+		FComment        string  `json:"FComment"`
+	} `json:"Header"`
+	Lines    []InvoiceDetailsLine    `json:"Lines"`
+	Payments []InvoiceDetailsPayment `json:"Payments"`
+	// Only filled when the request had AddAttachment set
+	Attachment *InvoiceAttachment `json:"Attachment,omitempty"`
+}
+
+type InvoiceDetailsLine struct {
+	ArticleCode    string  `json:"ArticleCode"`
+	ArticleDesc    string  `json:"ArticleDesc"`
+	Quantity       Decimal `json:"Quantity"`
+	Price          Decimal `json:"Price"`
+	DiscountPct    Decimal `json:"DiscountPct"`
+	DiscountAmount Decimal `json:"DiscountAmount"`
+	TaxID          string  `json:"TaxId"`
+	UOMName        string  `json:"UOMName"`
+	GLAccountCode  string  `json:"GLAccountCode"`
+	DepartmentCode string  `json:"DepartmentCode"`
+	ProjectCode    string  `json:"ProjectCode"`
+	LocationCode   string  `json:"LocationCode"`
+}
+
+type InvoiceDetailsPayment struct {
+	PaymentDate  Date    `json:"PaymentDate"`
+	Amount       Decimal `json:"Amount"`
+	CurrencyCode string  `json:"CurrencyCode"`
+}
+
+// InvoiceAttachment is the file attached to a sales or purchase invoice. The
+// API sends the content base64 encoded, it's decoded when unmarshalling.
+type InvoiceAttachment struct {
+	FileName    string `json:"FileName"`
+	FileContent []byte `json:"FileContent"`
+}
+
+func (r *GetInvoiceRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getinvoice", r.PathParams())
+}
+
+func (r *GetInvoiceRequest) Do(ctx context.Context, opts ...RequestOption) (GetInvoiceResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,23 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	"github.com/gofrs/uuid"
+)
+
+func TestGetInvoice(t *testing.T) {
+	req := client.NewGetInvoiceRequest()
+	req.RequestBody().ID = uuid.FromStringOrNil("17a6d491-3ed0-4a5e-ab28-11e18359929f")
+	req.RequestBody().AddAttachment = true
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,199 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetInvoicesRequest() GetInvoicesRequest {
+	r := GetInvoicesRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetInvoicesQueryParams()
+	r.pathParams = r.NewGetInvoicesPathParams()
+	r.requestBody = r.NewGetInvoicesRequestBody()
+	return r
+}
+
+type GetInvoicesRequest struct {
+	client      *Client
+	queryParams *GetInvoicesQueryParams
+	pathParams  *GetInvoicesPathParams
+	method      string
+	headers     http.Header
+	requestBody GetInvoicesRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetInvoicesRequest) Clone() GetInvoicesRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetInvoicesRequest) NewGetInvoicesQueryParams() *GetInvoicesQueryParams {
+	return &GetInvoicesQueryParams{}
+}
+
+type GetInvoicesQueryParams struct {
+}
+
+func (p GetInvoicesQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetInvoicesRequest) QueryParams() *GetInvoicesQueryParams {
+	return r.queryParams
+}
+
+func (r GetInvoicesRequest) NewGetInvoicesPathParams() *GetInvoicesPathParams {
+	return &GetInvoicesPathParams{}
+}
+
+type GetInvoicesPathParams struct {
+}
+
+func (p *GetInvoicesPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetInvoicesRequest) PathParams() *GetInvoicesPathParams {
+	return r.pathParams
+}
+
+func (r *GetInvoicesRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetInvoicesRequest) Method() string {
+	return r.method
+}
+
+func (r GetInvoicesRequest) NewGetInvoicesRequestBody() GetInvoicesRequestBody {
+	return GetInvoicesRequestBody{}
+}
+
+type GetInvoicesRequestBody struct {
+	PeriodStart Date `json:"PeriodStart"`
+	PeriodEnd   Date `json:"PeriodEnd"`
+	// Only return invoices that aren't fully paid
+	UnPaid bool `json:"UnPaid,omitempty"`
+	// Only return invoices changed after this moment, see SetChangedSince
+	ChangedDate *DateTime `json:"ChangedDate,omitempty"`
+}
+
+func (r *GetInvoicesRequest) RequestBody() *GetInvoicesRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetInvoicesRequest) SetRequestBody(body GetInvoicesRequestBody) {
+	r.requestBody = body
+}
+
+// SetChangedSince limits the results to invoices changed after t, for
+// incremental syncs. t is sent in the client's location. The zero time
+// removes the limit.
+func (r *GetInvoicesRequest) SetChangedSince(t time.Time) {
+	if t.IsZero() {
+		r.requestBody.ChangedDate = nil
+		return
+	}
+	changed := r.client.DateTime(t)
+	r.requestBody.ChangedDate = &changed
+}
+
+func (r *GetInvoicesRequest) NewResponseBody() *GetInvoicesResponseBody {
+	return &GetInvoicesResponseBody{}
+}
+
+type GetInvoicesResponseBody Invoices
+
+func (r *GetInvoicesRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getinvoices", r.PathParams())
+}
+
+func (r *GetInvoicesRequest) Do(ctx context.Context, opts ...RequestOption) (GetInvoicesResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type Invoices []Invoice
+
+// Invoice is a sales invoice in the invoice list
+type Invoice struct {
+	SIHID          string  `json:"SIHId"`
+	InvoiceNo      string  `json:"InvoiceNo"`
+	DocumentDate   Date    `json:"DocumentDate"`
+	DueDate        Date    `json:"DueDate"`
+	CustomerID     string  `json:"CustomerId"`
+	CustomerName   string  `json:"CustomerName"`
+	DepartmentCode string  `json:"DepartmentCode"`
+	ProjectCode    string  `json:"ProjectCode"`
+	CurrencyCode   string  `json:"CurrencyCode"`
+	TaxAmount      Decimal `json:"TaxAmount"`
+	TotalAmount    Decimal `json:"TotalAmount"`
+	PaidAmount     Decimal `json:"PaidAmount"`
+	HComment       string  `json:"HComment"`
+	FComment       string  `json:"FComment"`
+}
+
+// FindByInvoiceNo returns the invoices with the given number. Numbers are only
+// unique within a numbering series, so more than one can match.
+func (ii Invoices) FindByInvoiceNo(no string) Invoices {
+	found := Invoices{}
+	for _, i := range ii {
+		if i.InvoiceNo == no {
+			found = append(found, i)
+		}
+	}
+	return found
+}
@@ -0,0 +1,40 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestGetInvoices(t *testing.T) {
+	req := client.NewGetInvoicesRequest()
+	req.RequestBody().PeriodStart = aktiva.Date{time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	req.RequestBody().PeriodEnd = aktiva.Date{time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)}
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
+
+func TestGetInvoicesChangedSince(t *testing.T) {
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetLocation(time.FixedZone("EET", 2*60*60))
+
+	req := c.NewGetInvoicesRequest()
+	req.SetChangedSince(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+	if req.RequestBody().ChangedDate.String() != "20200102050405" {
+		t.Errorf("unexpected changed date %s", req.RequestBody().ChangedDate)
+	}
+
+	req.SetChangedSince(time.Time{})
+	if req.RequestBody().ChangedDate != nil {
+		t.Errorf("expected no changed date, got %s", req.RequestBody().ChangedDate)
+	}
+}
@@ -0,0 +1,156 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetItemGroupsRequest() GetItemGroupsRequest {
+	r := GetItemGroupsRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetItemGroupsQueryParams()
+	r.pathParams = r.NewGetItemGroupsPathParams()
+	r.requestBody = r.NewGetItemGroupsRequestBody()
+	return r
+}
+
+type GetItemGroupsRequest struct {
+	client      *Client
+	queryParams *GetItemGroupsQueryParams
+	pathParams  *GetItemGroupsPathParams
+	method      string
+	headers     http.Header
+	requestBody GetItemGroupsRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetItemGroupsRequest) Clone() GetItemGroupsRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetItemGroupsRequest) NewGetItemGroupsQueryParams() *GetItemGroupsQueryParams {
+	return &GetItemGroupsQueryParams{}
+}
+
+type GetItemGroupsQueryParams struct {
+}
+
+func (p GetItemGroupsQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetItemGroupsRequest) QueryParams() *GetItemGroupsQueryParams {
+	return r.queryParams
+}
+
+func (r GetItemGroupsRequest) NewGetItemGroupsPathParams() *GetItemGroupsPathParams {
+	return &GetItemGroupsPathParams{}
+}
+
+type GetItemGroupsPathParams struct {
+}
+
+func (p *GetItemGroupsPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetItemGroupsRequest) PathParams() *GetItemGroupsPathParams {
+	return r.pathParams
+}
+
+func (r *GetItemGroupsRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetItemGroupsRequest) Method() string {
+	return r.method
+}
+
+func (r GetItemGroupsRequest) NewGetItemGroupsRequestBody() GetItemGroupsRequestBody {
+	return GetItemGroupsRequestBody{}
+}
+
+type GetItemGroupsRequestBody struct {
+}
+
+func (r *GetItemGroupsRequest) RequestBody() *GetItemGroupsRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetItemGroupsRequest) SetRequestBody(body GetItemGroupsRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetItemGroupsRequest) NewResponseBody() *GetItemGroupsResponseBody {
+	return &GetItemGroupsResponseBody{}
+}
+
+type GetItemGroupsResponseBody ItemGroups
+
+func (r *GetItemGroupsRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getitemgroups", r.PathParams())
+}
+
+func (r *GetItemGroupsRequest) Do(ctx context.Context, opts ...RequestOption) (GetItemGroupsResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, nil)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type ItemGroups []ItemGroup
+
+type ItemGroup struct {
+	ID   string `json:"Id"`
+	Code string `json:"Code"`
+	Name string `json:"Name"`
+}
@@ -0,0 +1,225 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetItemsRequest() GetItemsRequest {
+	r := GetItemsRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetItemsQueryParams()
+	r.pathParams = r.NewGetItemsPathParams()
+	r.requestBody = r.NewGetItemsRequestBody()
+	return r
+}
+
+type GetItemsRequest struct {
+	client      *Client
+	queryParams *GetItemsQueryParams
+	pathParams  *GetItemsPathParams
+	method      string
+	headers     http.Header
+	requestBody GetItemsRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetItemsRequest) Clone() GetItemsRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetItemsRequest) NewGetItemsQueryParams() *GetItemsQueryParams {
+	return &GetItemsQueryParams{}
+}
+
+type GetItemsQueryParams struct {
+}
+
+func (p GetItemsQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetItemsRequest) QueryParams() *GetItemsQueryParams {
+	return r.queryParams
+}
+
+func (r GetItemsRequest) NewGetItemsPathParams() *GetItemsPathParams {
+	return &GetItemsPathParams{}
+}
+
+type GetItemsPathParams struct {
+}
+
+func (p *GetItemsPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetItemsRequest) PathParams() *GetItemsPathParams {
+	return r.pathParams
+}
+
+func (r *GetItemsRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetItemsRequest) Method() string {
+	return r.method
+}
+
+func (r GetItemsRequest) NewGetItemsRequestBody() GetItemsRequestBody {
+	return GetItemsRequestBody{}
+}
+
+type GetItemsRequestBody ItemsFilter
+
+func (r *GetItemsRequest) RequestBody() *GetItemsRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetItemsRequest) SetRequestBody(body GetItemsRequestBody) {
+	r.requestBody = body
+}
+
+// SetChangedSince limits the results to records changed after t, for
+// incremental syncs. t is sent in the client's location. The zero time
+// removes the limit.
+func (r *GetItemsRequest) SetChangedSince(t time.Time) {
+	if t.IsZero() {
+		r.requestBody.ChangedDate = nil
+		return
+	}
+	changed := r.client.DateTime(t)
+	r.requestBody.ChangedDate = &changed
+}
+
+func (r *GetItemsRequest) NewResponseBody() *GetItemsResponseBody {
+	return &GetItemsResponseBody{}
+}
+
+type GetItemsResponseBody Items
+
+func (r *GetItemsRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getitems", r.PathParams())
+}
+
+func (r *GetItemsRequest) Do(ctx context.Context, opts ...RequestOption) (GetItemsResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type ItemsFilter struct {
+	ID          *uuid.UUID `json:"Id,omitempty"`
+	Code        string     `json:"Code,omitempty"`
+	Description string     `json:"Description,omitempty"`
+	// Only return records changed after this moment, see SetChangedSince
+	ChangedDate *DateTime `json:"ChangedDate,omitempty"`
+}
+
+type Items []Item
+
+type Item struct {
+	ItemID               string   `json:"ItemId"`
+	Code                 string   `json:"Code"`
+	Name                 string   `json:"Name"`
+	UnitofMeasureName    string   `json:"UnitofMeasureName"`
+	Type                 ItemType `json:"Type"`
+	SalesPrice           Decimal  `json:"SalesPrice"`
+	InventoryQty         Decimal  `json:"InventoryQty"`
+	ItemGroupName        string   `json:"ItemGroupName"`
+	TaxID                string   `json:"TaxId"`
+	SalesAccountCode     string   `json:"SalesAccountCode"`
+	PurchaseAccountCode  string   `json:"PurchaseAccountCode"`
+	InventoryAccountCode string   `json:"InventoryAccountCode"`
+	CostAccountCode      string   `json:"CostAccountCode"`
+	// Translated item names, keyed by language code (ET, EN, FI, ...)
+	Descriptions ItemDescriptions `json:"Descriptions"`
+}
+
+// ItemDescriptions holds translated item names keyed by language code
+type ItemDescriptions map[string]string
+
+// Get returns the description in lang (case insensitive) and reports whether
+// it was found
+func (dd ItemDescriptions) Get(lang string) (string, bool) {
+	for l, d := range dd {
+		if strings.EqualFold(l, lang) && d != "" {
+			return d, true
+		}
+	}
+	return "", false
+}
+
+// Description returns the item name in lang, falling back to the default name
+// when there is no translation
+func (i Item) Description(lang string) string {
+	if d, ok := i.Descriptions.Get(lang); ok {
+		return d
+	}
+	return i.Name
+}
+
+// Article returns the item as used on invoice rows, with the description in
+// lang. Use the customer's SalesInvLang to print invoices in the customer's
+// language.
+func (i Item) Article(lang string) Article {
+	return Article{
+		Code:        i.Code,
+		Description: i.Description(lang),
+		Type:        i.Type,
+		UOMName:     i.UnitofMeasureName,
+	}
+}
@@ -0,0 +1,40 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestGetItems(t *testing.T) {
+	req := client.NewGetItemsRequest()
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
+
+func TestItemArticle(t *testing.T) {
+	item := aktiva.Item{
+		Code: "1234567",
+		Name: "Kuldhelbed",
+		Type: aktiva.ItemTypeItem,
+		Descriptions: aktiva.ItemDescriptions{
+			"EN": "Bag of goldflakes",
+		},
+	}
+
+	if a := item.Article("en"); a.Description != "Bag of goldflakes" {
+		t.Errorf("expected English description, got %s", a.Description)
+	}
+
+	if a := item.Article("FI"); a.Description != "Kuldhelbed" {
+		t.Errorf("expected default description, got %s", a.Description)
+	}
+}
@@ -0,0 +1,167 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetLocationsRequest() GetLocationsRequest {
+	r := GetLocationsRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetLocationsQueryParams()
+	r.pathParams = r.NewGetLocationsPathParams()
+	r.requestBody = r.NewGetLocationsRequestBody()
+	return r
+}
+
+type GetLocationsRequest struct {
+	client      *Client
+	queryParams *GetLocationsQueryParams
+	pathParams  *GetLocationsPathParams
+	method      string
+	headers     http.Header
+	requestBody GetLocationsRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetLocationsRequest) Clone() GetLocationsRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetLocationsRequest) NewGetLocationsQueryParams() *GetLocationsQueryParams {
+	return &GetLocationsQueryParams{}
+}
+
+type GetLocationsQueryParams struct {
+}
+
+func (p GetLocationsQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetLocationsRequest) QueryParams() *GetLocationsQueryParams {
+	return r.queryParams
+}
+
+func (r GetLocationsRequest) NewGetLocationsPathParams() *GetLocationsPathParams {
+	return &GetLocationsPathParams{}
+}
+
+type GetLocationsPathParams struct {
+}
+
+func (p *GetLocationsPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetLocationsRequest) PathParams() *GetLocationsPathParams {
+	return r.pathParams
+}
+
+func (r *GetLocationsRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetLocationsRequest) Method() string {
+	return r.method
+}
+
+func (r GetLocationsRequest) NewGetLocationsRequestBody() GetLocationsRequestBody {
+	return GetLocationsRequestBody{}
+}
+
+type GetLocationsRequestBody struct {
+}
+
+func (r *GetLocationsRequest) RequestBody() *GetLocationsRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetLocationsRequest) SetRequestBody(body GetLocationsRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetLocationsRequest) NewResponseBody() *GetLocationsResponseBody {
+	return &GetLocationsResponseBody{}
+}
+
+type GetLocationsResponseBody Locations
+
+func (r *GetLocationsRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getlocations", r.PathParams())
+}
+
+func (r *GetLocationsRequest) Do(ctx context.Context, opts ...RequestOption) (GetLocationsResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, nil)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type Locations []Location
+
+// Location is a warehouse, referenced by LocationCode on invoice rows
+type Location struct {
+	ID   string `json:"Id"`
+	Code string `json:"Code"`
+	Name string `json:"Name"`
+}
+
+// FindByCode returns the location with the given location code
+func (ll Locations) FindByCode(code string) (Location, bool) {
+	for _, l := range ll {
+		if l.Code == code {
+			return l, true
+		}
+	}
+	return Location{}, false
+}
@@ -0,0 +1,200 @@
+package aktiva
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetPeriodsRequest() GetPeriodsRequest {
+	r := GetPeriodsRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetPeriodsQueryParams()
+	r.pathParams = r.NewGetPeriodsPathParams()
+	r.requestBody = r.NewGetPeriodsRequestBody()
+	return r
+}
+
+type GetPeriodsRequest struct {
+	client      *Client
+	queryParams *GetPeriodsQueryParams
+	pathParams  *GetPeriodsPathParams
+	method      string
+	headers     http.Header
+	requestBody GetPeriodsRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetPeriodsRequest) Clone() GetPeriodsRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetPeriodsRequest) NewGetPeriodsQueryParams() *GetPeriodsQueryParams {
+	return &GetPeriodsQueryParams{}
+}
+
+type GetPeriodsQueryParams struct {
+}
+
+func (p GetPeriodsQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetPeriodsRequest) QueryParams() *GetPeriodsQueryParams {
+	return r.queryParams
+}
+
+func (r GetPeriodsRequest) NewGetPeriodsPathParams() *GetPeriodsPathParams {
+	return &GetPeriodsPathParams{}
+}
+
+type GetPeriodsPathParams struct {
+}
+
+func (p *GetPeriodsPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetPeriodsRequest) PathParams() *GetPeriodsPathParams {
+	return r.pathParams
+}
+
+func (r *GetPeriodsRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetPeriodsRequest) Method() string {
+	return r.method
+}
+
+func (r GetPeriodsRequest) NewGetPeriodsRequestBody() GetPeriodsRequestBody {
+	return GetPeriodsRequestBody{}
+}
+
+type GetPeriodsRequestBody struct {
+}
+
+func (r *GetPeriodsRequest) RequestBody() *GetPeriodsRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetPeriodsRequest) SetRequestBody(body GetPeriodsRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetPeriodsRequest) NewResponseBody() *GetPeriodsResponseBody {
+	return &GetPeriodsResponseBody{}
+}
+
+type GetPeriodsResponseBody Periods
+
+func (r *GetPeriodsRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getperiods", r.PathParams())
+}
+
+func (r *GetPeriodsRequest) Do(ctx context.Context, opts ...RequestOption) (GetPeriodsResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type Periods []Period
+
+// Period is a financial period of the company. Documents dated in a locked
+// period are rejected.
+type Period struct {
+	ID        string `json:"Id"`
+	Name      string `json:"Name"`
+	StartDate Date   `json:"StartDate"`
+	EndDate   Date   `json:"EndDate"`
+	Locked    bool   `json:"Locked"`
+}
+
+// LockedUntil returns the end date of the last locked period, the zero time
+// when no period is locked
+func (pp Periods) LockedUntil() time.Time {
+	until := time.Time{}
+	for _, p := range pp {
+		if p.Locked && p.EndDate.After(until) {
+			until = p.EndDate.Time
+		}
+	}
+	return until
+}
+
+// IsLocked reports whether documents dated on t are rejected
+func (pp Periods) IsLocked(t time.Time) bool {
+	for _, p := range pp {
+		if !p.Locked {
+			continue
+		}
+		if !t.Before(p.StartDate.Time) && t.Before(p.EndDate.AddDate(0, 0, 1)) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckDocumentDate returns a PeriodClosedError when a document dated on date
+// would be rejected because its period is locked
+func (pp Periods) CheckDocumentDate(date Date) error {
+	if !pp.IsLocked(date.Time) {
+		return nil
+	}
+
+	return PeriodClosedError{
+		Period: date.String(),
+		Cause:  Error{Message: fmt.Sprintf("period of %s is closed", date.Format("2006-01-02"))},
+	}
+}
@@ -0,0 +1,50 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestGetPeriods(t *testing.T) {
+	req := client.NewGetPeriodsRequest()
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
+
+func TestPeriodsCheckDocumentDate(t *testing.T) {
+	periods := aktiva.Periods{
+		{
+			StartDate: aktiva.Date{Time: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)},
+			EndDate:   aktiva.Date{Time: time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC)},
+			Locked:    true,
+		},
+		{
+			StartDate: aktiva.Date{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+			EndDate:   aktiva.Date{Time: time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	err := periods.CheckDocumentDate(aktiva.Date{Time: time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC)})
+	if _, ok := err.(aktiva.PeriodClosedError); !ok {
+		t.Errorf("expected PeriodClosedError, got %v", err)
+	}
+
+	err = periods.CheckDocumentDate(aktiva.Date{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !periods.LockedUntil().Equal(time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected locked until: %s", periods.LockedUntil())
+	}
+}
@@ -0,0 +1,182 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetPrepaymentInvoicesRequest() GetPrepaymentInvoicesRequest {
+	r := GetPrepaymentInvoicesRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetPrepaymentInvoicesQueryParams()
+	r.pathParams = r.NewGetPrepaymentInvoicesPathParams()
+	r.requestBody = r.NewGetPrepaymentInvoicesRequestBody()
+	return r
+}
+
+type GetPrepaymentInvoicesRequest struct {
+	client      *Client
+	queryParams *GetPrepaymentInvoicesQueryParams
+	pathParams  *GetPrepaymentInvoicesPathParams
+	method      string
+	headers     http.Header
+	requestBody GetPrepaymentInvoicesRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetPrepaymentInvoicesRequest) Clone() GetPrepaymentInvoicesRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetPrepaymentInvoicesRequest) NewGetPrepaymentInvoicesQueryParams() *GetPrepaymentInvoicesQueryParams {
+	return &GetPrepaymentInvoicesQueryParams{}
+}
+
+type GetPrepaymentInvoicesQueryParams struct {
+}
+
+func (p GetPrepaymentInvoicesQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetPrepaymentInvoicesRequest) QueryParams() *GetPrepaymentInvoicesQueryParams {
+	return r.queryParams
+}
+
+func (r GetPrepaymentInvoicesRequest) NewGetPrepaymentInvoicesPathParams() *GetPrepaymentInvoicesPathParams {
+	return &GetPrepaymentInvoicesPathParams{}
+}
+
+type GetPrepaymentInvoicesPathParams struct {
+}
+
+func (p *GetPrepaymentInvoicesPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetPrepaymentInvoicesRequest) PathParams() *GetPrepaymentInvoicesPathParams {
+	return r.pathParams
+}
+
+func (r *GetPrepaymentInvoicesRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetPrepaymentInvoicesRequest) Method() string {
+	return r.method
+}
+
+func (r GetPrepaymentInvoicesRequest) NewGetPrepaymentInvoicesRequestBody() GetPrepaymentInvoicesRequestBody {
+	return GetPrepaymentInvoicesRequestBody{}
+}
+
+type GetPrepaymentInvoicesRequestBody struct {
+	PeriodStart Date `json:"PeriodStart"`
+	PeriodEnd   Date `json:"PeriodEnd"`
+}
+
+func (r *GetPrepaymentInvoicesRequest) RequestBody() *GetPrepaymentInvoicesRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetPrepaymentInvoicesRequest) SetRequestBody(body GetPrepaymentInvoicesRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetPrepaymentInvoicesRequest) NewResponseBody() *GetPrepaymentInvoicesResponseBody {
+	return &GetPrepaymentInvoicesResponseBody{}
+}
+
+type GetPrepaymentInvoicesResponseBody PrepaymentInvoices
+
+func (r *GetPrepaymentInvoicesRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getprepaymentinvoices", r.PathParams())
+}
+
+func (r *GetPrepaymentInvoicesRequest) Do(ctx context.Context, opts ...RequestOption) (GetPrepaymentInvoicesResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type PrepaymentInvoices []PrepaymentInvoice
+
+// PrepaymentInvoice is an invoice for an advance payment. VAT is due when the
+// prepayment is received and is deducted again on the final invoices the
+// prepayment is used on.
+type PrepaymentInvoice struct {
+	InvoiceID    string  `json:"InvoiceId"`
+	InvoiceNo    string  `json:"InvoiceNo"`
+	CustomerID   string  `json:"CustomerId"`
+	CustomerName string  `json:"CustomerName"`
+	DocDate      Date    `json:"DocDate"`
+	CurrencyCode string  `json:"CurrencyCode"`
+	TotalAmount  Decimal `json:"TotalAmount"`
+	TaxAmount    Decimal `json:"TaxAmount"`
+	// Amount already used on final invoices
+	UsedAmount Decimal `json:"UsedAmount"`
+	// Final invoices the prepayment was used on
+	FinalInvoiceIDs []string `json:"FinalInvoiceIds"`
+}
+
+// Remaining returns the part of the prepayment that isn't used on final
+// invoices yet
+func (p PrepaymentInvoice) Remaining() Decimal {
+	return p.TotalAmount.Sub(p.UsedAmount)
+}
+
+// Link returns a reference using the remaining amount of the prepayment, to
+// be added to the Prepayments of a final invoice
+func (p PrepaymentInvoice) Link() PrepaymentLink {
+	return PrepaymentLink{InvoiceNo: p.InvoiceNo, Amount: p.Remaining()}
+}
@@ -0,0 +1,172 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetPricesRequest() GetPricesRequest {
+	r := GetPricesRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetPricesQueryParams()
+	r.pathParams = r.NewGetPricesPathParams()
+	r.requestBody = r.NewGetPricesRequestBody()
+	return r
+}
+
+type GetPricesRequest struct {
+	client      *Client
+	queryParams *GetPricesQueryParams
+	pathParams  *GetPricesPathParams
+	method      string
+	headers     http.Header
+	requestBody GetPricesRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetPricesRequest) Clone() GetPricesRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetPricesRequest) NewGetPricesQueryParams() *GetPricesQueryParams {
+	return &GetPricesQueryParams{}
+}
+
+type GetPricesQueryParams struct {
+}
+
+func (p GetPricesQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetPricesRequest) QueryParams() *GetPricesQueryParams {
+	return r.queryParams
+}
+
+func (r GetPricesRequest) NewGetPricesPathParams() *GetPricesPathParams {
+	return &GetPricesPathParams{}
+}
+
+type GetPricesPathParams struct {
+}
+
+func (p *GetPricesPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetPricesRequest) PathParams() *GetPricesPathParams {
+	return r.pathParams
+}
+
+func (r *GetPricesRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetPricesRequest) Method() string {
+	return r.method
+}
+
+func (r GetPricesRequest) NewGetPricesRequestBody() GetPricesRequestBody {
+	return GetPricesRequestBody{}
+}
+
+type GetPricesRequestBody PricesFilter
+
+func (r *GetPricesRequest) RequestBody() *GetPricesRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetPricesRequest) SetRequestBody(body GetPricesRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetPricesRequest) NewResponseBody() *GetPricesResponseBody {
+	return &GetPricesResponseBody{}
+}
+
+type GetPricesResponseBody Prices
+
+func (r *GetPricesRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getprices", r.PathParams())
+}
+
+func (r *GetPricesRequest) Do(ctx context.Context, opts ...RequestOption) (GetPricesResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type PricesFilter struct {
+	ItemCode   string     `json:"ItemCode,omitempty"`
+	CustomerID *uuid.UUID `json:"CustomerId,omitempty"`
+	// Only return prices valid on this date
+	DocDate Date `json:"DocDate"`
+}
+
+type Prices []Price
+
+// Price is a special price for an item, optionally limited to a customer or
+// customer group and a validity period
+type Price struct {
+	ItemCode          string  `json:"ItemCode"`
+	ItemName          string  `json:"ItemName"`
+	CustomerID        string  `json:"CustomerId"`
+	CustomerName      string  `json:"CustomerName"`
+	CustomerGroupName string  `json:"CustomerGroupName"`
+	StartDate         Date    `json:"StartDate"`
+	EndDate           Date    `json:"EndDate"`
+	Price             Decimal `json:"Price"`
+	DiscountPct       Decimal `json:"DiscountPct"`
+	CurrencyCode      string  `json:"CurrencyCode"`
+}
@@ -0,0 +1,20 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestGetPrices(t *testing.T) {
+	req := client.NewGetPricesRequest()
+	req.RequestBody().ItemCode = "1234567"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
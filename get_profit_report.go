@@ -0,0 +1,180 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetProfitReportRequest() GetProfitReportRequest {
+	r := GetProfitReportRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetProfitReportQueryParams()
+	r.pathParams = r.NewGetProfitReportPathParams()
+	r.requestBody = r.NewGetProfitReportRequestBody()
+	return r
+}
+
+type GetProfitReportRequest struct {
+	client      *Client
+	queryParams *GetProfitReportQueryParams
+	pathParams  *GetProfitReportPathParams
+	method      string
+	headers     http.Header
+	requestBody GetProfitReportRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetProfitReportRequest) Clone() GetProfitReportRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetProfitReportRequest) NewGetProfitReportQueryParams() *GetProfitReportQueryParams {
+	return &GetProfitReportQueryParams{}
+}
+
+type GetProfitReportQueryParams struct {
+}
+
+func (p GetProfitReportQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetProfitReportRequest) QueryParams() *GetProfitReportQueryParams {
+	return r.queryParams
+}
+
+func (r GetProfitReportRequest) NewGetProfitReportPathParams() *GetProfitReportPathParams {
+	return &GetProfitReportPathParams{}
+}
+
+type GetProfitReportPathParams struct {
+}
+
+func (p *GetProfitReportPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetProfitReportRequest) PathParams() *GetProfitReportPathParams {
+	return r.pathParams
+}
+
+func (r *GetProfitReportRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetProfitReportRequest) Method() string {
+	return r.method
+}
+
+func (r GetProfitReportRequest) NewGetProfitReportRequestBody() GetProfitReportRequestBody {
+	return GetProfitReportRequestBody{}
+}
+
+type GetProfitReportRequestBody struct {
+	// Last day of the report
+	EndDate Date `json:"EndDate"`
+	// Number of periods (months) reported, counting back from EndDate
+	PerCount int `json:"PerCount,omitempty"`
+	// Limit the report to this department code, all departments when empty
+	DepFilter string `json:"DepFilter,omitempty"`
+}
+
+func (r *GetProfitReportRequest) RequestBody() *GetProfitReportRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetProfitReportRequest) SetRequestBody(body GetProfitReportRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetProfitReportRequest) NewResponseBody() *GetProfitReportResponseBody {
+	return &GetProfitReportResponseBody{}
+}
+
+type GetProfitReportResponseBody ProfitReportRows
+
+func (r *GetProfitReportRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getprofitrep", r.PathParams())
+}
+
+func (r *GetProfitReportRequest) Do(ctx context.Context, opts ...RequestOption) (GetProfitReportResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type ProfitReportRows []ProfitReportRow
+
+// ProfitReportRow is an account line of the profit and loss statement with
+// one amount per reported period, oldest period first
+type ProfitReportRow struct {
+	AccountCode string    `json:"AccountCode"`
+	AccountName string    `json:"AccountName"`
+	Amounts     []Decimal `json:"Amounts"`
+}
+
+// Total returns the sum of the row over all reported periods
+func (r ProfitReportRow) Total() Decimal {
+	return SumDecimals(r.Amounts...)
+}
+
+// FindByAccountCode returns the row of the account, false when the account
+// isn't in the report
+func (rr ProfitReportRows) FindByAccountCode(code string) (ProfitReportRow, bool) {
+	for _, r := range rr {
+		if r.AccountCode == code {
+			return r, true
+		}
+	}
+	return ProfitReportRow{}, false
+}
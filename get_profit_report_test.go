@@ -0,0 +1,24 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestGetProfitReport(t *testing.T) {
+	req := client.NewGetProfitReportRequest()
+	req.RequestBody().EndDate = aktiva.Date{time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)}
+	req.RequestBody().PerCount = 1
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,159 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetProjectsRequest() GetProjectsRequest {
+	r := GetProjectsRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetProjectsQueryParams()
+	r.pathParams = r.NewGetProjectsPathParams()
+	r.requestBody = r.NewGetProjectsRequestBody()
+	return r
+}
+
+type GetProjectsRequest struct {
+	client      *Client
+	queryParams *GetProjectsQueryParams
+	pathParams  *GetProjectsPathParams
+	method      string
+	headers     http.Header
+	requestBody GetProjectsRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetProjectsRequest) Clone() GetProjectsRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetProjectsRequest) NewGetProjectsQueryParams() *GetProjectsQueryParams {
+	return &GetProjectsQueryParams{}
+}
+
+type GetProjectsQueryParams struct {
+}
+
+func (p GetProjectsQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetProjectsRequest) QueryParams() *GetProjectsQueryParams {
+	return r.queryParams
+}
+
+func (r GetProjectsRequest) NewGetProjectsPathParams() *GetProjectsPathParams {
+	return &GetProjectsPathParams{}
+}
+
+type GetProjectsPathParams struct {
+}
+
+func (p *GetProjectsPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetProjectsRequest) PathParams() *GetProjectsPathParams {
+	return r.pathParams
+}
+
+func (r *GetProjectsRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetProjectsRequest) Method() string {
+	return r.method
+}
+
+func (r GetProjectsRequest) NewGetProjectsRequestBody() GetProjectsRequestBody {
+	return GetProjectsRequestBody{}
+}
+
+type GetProjectsRequestBody struct {
+}
+
+func (r *GetProjectsRequest) RequestBody() *GetProjectsRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetProjectsRequest) SetRequestBody(body GetProjectsRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetProjectsRequest) NewResponseBody() *GetProjectsResponseBody {
+	return &GetProjectsResponseBody{}
+}
+
+type GetProjectsResponseBody Projects
+
+func (r *GetProjectsRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getprojects", r.PathParams())
+}
+
+func (r *GetProjectsRequest) Do(ctx context.Context, opts ...RequestOption) (GetProjectsResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, nil)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type Projects []Project
+
+type Project struct {
+	ID        string `json:"Id"`
+	Code      string `json:"Code"`
+	Name      string `json:"Name"`
+	NonActive bool   `json:"NonActive"`
+	StartDate string `json:"StartDate"`
+	EndDate   string `json:"EndDate"`
+}
@@ -0,0 +1,175 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetPurchaseInvoiceRequest() GetPurchaseInvoiceRequest {
+	r := GetPurchaseInvoiceRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetPurchaseInvoiceQueryParams()
+	r.pathParams = r.NewGetPurchaseInvoicePathParams()
+	r.requestBody = r.NewGetPurchaseInvoiceRequestBody()
+	return r
+}
+
+type GetPurchaseInvoiceRequest struct {
+	client      *Client
+	queryParams *GetPurchaseInvoiceQueryParams
+	pathParams  *GetPurchaseInvoicePathParams
+	method      string
+	headers     http.Header
+	requestBody GetPurchaseInvoiceRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetPurchaseInvoiceRequest) Clone() GetPurchaseInvoiceRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetPurchaseInvoiceRequest) NewGetPurchaseInvoiceQueryParams() *GetPurchaseInvoiceQueryParams {
+	return &GetPurchaseInvoiceQueryParams{}
+}
+
+type GetPurchaseInvoiceQueryParams struct {
+}
+
+func (p GetPurchaseInvoiceQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetPurchaseInvoiceRequest) QueryParams() *GetPurchaseInvoiceQueryParams {
+	return r.queryParams
+}
+
+func (r GetPurchaseInvoiceRequest) NewGetPurchaseInvoicePathParams() *GetPurchaseInvoicePathParams {
+	return &GetPurchaseInvoicePathParams{}
+}
+
+type GetPurchaseInvoicePathParams struct {
+}
+
+func (p *GetPurchaseInvoicePathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetPurchaseInvoiceRequest) PathParams() *GetPurchaseInvoicePathParams {
+	return r.pathParams
+}
+
+func (r *GetPurchaseInvoiceRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetPurchaseInvoiceRequest) Method() string {
+	return r.method
+}
+
+func (r GetPurchaseInvoiceRequest) NewGetPurchaseInvoiceRequestBody() GetPurchaseInvoiceRequestBody {
+	return GetPurchaseInvoiceRequestBody{}
+}
+
+type GetPurchaseInvoiceRequestBody struct {
+	ID uuid.UUID `json:"Id"`
+	// Leave out the attached file, e.g. the scanned bill
+	SkipAttachment bool `json:"SkipAttachment,omitempty"`
+}
+
+func (r *GetPurchaseInvoiceRequest) RequestBody() *GetPurchaseInvoiceRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetPurchaseInvoiceRequest) SetRequestBody(body GetPurchaseInvoiceRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetPurchaseInvoiceRequest) NewResponseBody() *GetPurchaseInvoiceResponseBody {
+	return &GetPurchaseInvoiceResponseBody{}
+}
+
+// GetPurchaseInvoiceResponseBody is a purchase invoice with its rows and
+// payments
+type GetPurchaseInvoiceResponseBody struct {
+	Header struct {
+		PIHID           string  `json:"PIHId"`
+		BillNo          string  `json:"BillNo"`
+		VendorID        string  `json:"VendorId"`
+		VendorName      string  `json:"VendorName"`
+		DocumentDate    Date    `json:"DocumentDate"`
+		TransactionDate Date    `json:"TransactionDate"`
+		DueDate         Date    `json:"DueDate"`
+		CurrencyCode    string  `json:"CurrencyCode"`
+		CurrencyRate    Decimal `json:"CurrencyRate"`
+		TaxAmount       Decimal `json:"TaxAmount"`
+		TotalAmount     Decimal `json:"TotalAmount"`
+		RoundingAmount  Decimal `json:"RoundingAmount"`
+		HComment        string  `json:"HComment"`
+		FComment        string  `json:"FComment"`
+	} `json:"Header"`
+	Lines    []InvoiceDetailsLine    `json:"Lines"`
+	Payments []InvoiceDetailsPayment `json:"Payments"`
+	// Empty when the request had SkipAttachment set
+	Attachment *InvoiceAttachment `json:"Attachment,omitempty"`
+}
+
+func (r *GetPurchaseInvoiceRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getpurchorder", r.PathParams())
+}
+
+func (r *GetPurchaseInvoiceRequest) Do(ctx context.Context, opts ...RequestOption) (GetPurchaseInvoiceResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
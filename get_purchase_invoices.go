@@ -0,0 +1,180 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetPurchaseInvoicesRequest() GetPurchaseInvoicesRequest {
+	r := GetPurchaseInvoicesRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetPurchaseInvoicesQueryParams()
+	r.pathParams = r.NewGetPurchaseInvoicesPathParams()
+	r.requestBody = r.NewGetPurchaseInvoicesRequestBody()
+	return r
+}
+
+type GetPurchaseInvoicesRequest struct {
+	client      *Client
+	queryParams *GetPurchaseInvoicesQueryParams
+	pathParams  *GetPurchaseInvoicesPathParams
+	method      string
+	headers     http.Header
+	requestBody GetPurchaseInvoicesRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetPurchaseInvoicesRequest) Clone() GetPurchaseInvoicesRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetPurchaseInvoicesRequest) NewGetPurchaseInvoicesQueryParams() *GetPurchaseInvoicesQueryParams {
+	return &GetPurchaseInvoicesQueryParams{}
+}
+
+type GetPurchaseInvoicesQueryParams struct {
+}
+
+func (p GetPurchaseInvoicesQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetPurchaseInvoicesRequest) QueryParams() *GetPurchaseInvoicesQueryParams {
+	return r.queryParams
+}
+
+func (r GetPurchaseInvoicesRequest) NewGetPurchaseInvoicesPathParams() *GetPurchaseInvoicesPathParams {
+	return &GetPurchaseInvoicesPathParams{}
+}
+
+type GetPurchaseInvoicesPathParams struct {
+}
+
+func (p *GetPurchaseInvoicesPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetPurchaseInvoicesRequest) PathParams() *GetPurchaseInvoicesPathParams {
+	return r.pathParams
+}
+
+func (r *GetPurchaseInvoicesRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetPurchaseInvoicesRequest) Method() string {
+	return r.method
+}
+
+func (r GetPurchaseInvoicesRequest) NewGetPurchaseInvoicesRequestBody() GetPurchaseInvoicesRequestBody {
+	return GetPurchaseInvoicesRequestBody{}
+}
+
+type GetPurchaseInvoicesRequestBody struct {
+	PeriodStart Date `json:"PeriodStart"`
+	PeriodEnd   Date `json:"PeriodEnd"`
+	// Only return invoices that aren't fully paid
+	UnPaid bool `json:"UnPaid,omitempty"`
+}
+
+func (r *GetPurchaseInvoicesRequest) RequestBody() *GetPurchaseInvoicesRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetPurchaseInvoicesRequest) SetRequestBody(body GetPurchaseInvoicesRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetPurchaseInvoicesRequest) NewResponseBody() *GetPurchaseInvoicesResponseBody {
+	return &GetPurchaseInvoicesResponseBody{}
+}
+
+type GetPurchaseInvoicesResponseBody PurchaseInvoices
+
+func (r *GetPurchaseInvoicesRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getpurchorders", r.PathParams())
+}
+
+func (r *GetPurchaseInvoicesRequest) Do(ctx context.Context, opts ...RequestOption) (GetPurchaseInvoicesResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type PurchaseInvoices []PurchaseInvoice
+
+// PurchaseInvoice is a purchase invoice in the invoice list
+type PurchaseInvoice struct {
+	PIHID        string  `json:"PIHId"`
+	BillNo       string  `json:"BillNo"`
+	DocumentDate Date    `json:"DocumentDate"`
+	DueDate      Date    `json:"DueDate"`
+	VendorID     string  `json:"VendorId"`
+	VendorName   string  `json:"VendorName"`
+	CurrencyCode string  `json:"CurrencyCode"`
+	TaxAmount    Decimal `json:"TaxAmount"`
+	TotalAmount  Decimal `json:"TotalAmount"`
+	PaidAmount   Decimal `json:"PaidAmount"`
+}
+
+// FindByBillNo returns the invoices with the given number of the vendor's
+// bill. Numbers are only unique per vendor, so more than one can match.
+func (ii PurchaseInvoices) FindByBillNo(no string) PurchaseInvoices {
+	found := PurchaseInvoices{}
+	for _, i := range ii {
+		if i.BillNo == no {
+			found = append(found, i)
+		}
+	}
+	return found
+}
@@ -0,0 +1,24 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestGetPurchaseInvoices(t *testing.T) {
+	req := client.NewGetPurchaseInvoicesRequest()
+	req.RequestBody().PeriodStart = aktiva.Date{time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	req.RequestBody().PeriodEnd = aktiva.Date{time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)}
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
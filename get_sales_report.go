@@ -0,0 +1,183 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetSalesReportRequest() GetSalesReportRequest {
+	r := GetSalesReportRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetSalesReportQueryParams()
+	r.pathParams = r.NewGetSalesReportPathParams()
+	r.requestBody = r.NewGetSalesReportRequestBody()
+	return r
+}
+
+type GetSalesReportRequest struct {
+	client      *Client
+	queryParams *GetSalesReportQueryParams
+	pathParams  *GetSalesReportPathParams
+	method      string
+	headers     http.Header
+	requestBody GetSalesReportRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetSalesReportRequest) Clone() GetSalesReportRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetSalesReportRequest) NewGetSalesReportQueryParams() *GetSalesReportQueryParams {
+	return &GetSalesReportQueryParams{}
+}
+
+type GetSalesReportQueryParams struct {
+}
+
+func (p GetSalesReportQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetSalesReportRequest) QueryParams() *GetSalesReportQueryParams {
+	return r.queryParams
+}
+
+func (r GetSalesReportRequest) NewGetSalesReportPathParams() *GetSalesReportPathParams {
+	return &GetSalesReportPathParams{}
+}
+
+type GetSalesReportPathParams struct {
+}
+
+func (p *GetSalesReportPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetSalesReportRequest) PathParams() *GetSalesReportPathParams {
+	return r.pathParams
+}
+
+func (r *GetSalesReportRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetSalesReportRequest) Method() string {
+	return r.method
+}
+
+func (r GetSalesReportRequest) NewGetSalesReportRequestBody() GetSalesReportRequestBody {
+	return GetSalesReportRequestBody{}
+}
+
+type GetSalesReportRequestBody struct {
+	PeriodStart Date                `json:"PeriodStart"`
+	PeriodEnd   Date                `json:"PeriodEnd"`
+	GroupBy     SalesReportGrouping `json:"GroupBy"`
+	// Limit the report to this customer, all customers when empty
+	CustName string `json:"CustName,omitempty"`
+	// Limit the report to this item, all items when empty
+	ItemCode string `json:"ItemCode,omitempty"`
+}
+
+func (r *GetSalesReportRequest) RequestBody() *GetSalesReportRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetSalesReportRequest) SetRequestBody(body GetSalesReportRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetSalesReportRequest) NewResponseBody() *GetSalesReportResponseBody {
+	return &GetSalesReportResponseBody{}
+}
+
+type GetSalesReportResponseBody SalesReportRows
+
+func (r *GetSalesReportRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getsalesrep", r.PathParams())
+}
+
+func (r *GetSalesReportRequest) Do(ctx context.Context, opts ...RequestOption) (GetSalesReportResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type SalesReportRows []SalesReportRow
+
+// SalesReportRow is the turnover of one item, customer or period, depending on
+// the GroupBy of the request. Amounts are in the company currency.
+type SalesReportRow struct {
+	Code      string  `json:"Code"`
+	Name      string  `json:"Name"`
+	Quantity  Decimal `json:"Quantity"`
+	Amount    Decimal `json:"Amount"`
+	VatAmount Decimal `json:"VatAmount"`
+	// Cost of the sold stock items, zero for services
+	CostAmount Decimal `json:"CostAmount"`
+}
+
+// Margin returns the turnover minus the cost of the sold items
+func (r SalesReportRow) Margin() Decimal {
+	return r.Amount.Sub(r.CostAmount)
+}
+
+// Total returns the summed turnover of all rows, excluding VAT
+func (rr SalesReportRows) Total() Decimal {
+	total := Decimal{}
+	for _, r := range rr {
+		total = total.Add(r.Amount)
+	}
+	return total
+}
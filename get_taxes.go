@@ -1,9 +1,11 @@
 package aktiva
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 
+	"github.com/gofrs/uuid"
 	"github.com/omniboost/go-merit-aktiva/utils"
 )
 
@@ -29,6 +31,22 @@ type GetTaxesRequest struct {
 	requestBody GetTaxesRequestBody
 }
 
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetTaxesRequest) Clone() GetTaxesRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
 func (r GetTaxesRequest) NewGetTaxesQueryParams() *GetTaxesQueryParams {
 	return &GetTaxesQueryParams{}
 }
@@ -36,7 +54,7 @@ func (r GetTaxesRequest) NewGetTaxesQueryParams() *GetTaxesQueryParams {
 type GetTaxesQueryParams struct{}
 
 func (p GetTaxesQueryParams) ToURLValues() (url.Values, error) {
-	encoder := utils.NewSchemaEncoder()
+	encoder := NewSchemaEncoder()
 	params := url.Values{}
 
 	err := encoder.Encode(p, params)
@@ -95,19 +113,31 @@ func (r *GetTaxesRequest) NewResponseBody() *GetTaxesResponseBody {
 
 type GetTaxesResponseBody Taxes
 
-func (r *GetTaxesRequest) URL() url.URL {
+func (r *GetTaxesRequest) URL() (url.URL, error) {
 	return r.client.GetEndpointURL("gettaxes", r.PathParams())
 }
 
-func (r *GetTaxesRequest) Do() (GetTaxesResponseBody, error) {
+func (r *GetTaxesRequest) Do(ctx context.Context, opts ...RequestOption) (GetTaxesResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
 	// Create http request
-	req, err := r.client.NewRequest(nil, r.Method(), r.URL(), nil)
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, nil)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
 
 	// Process query parameters
-	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
@@ -119,9 +149,34 @@ func (r *GetTaxesRequest) Do() (GetTaxesResponseBody, error) {
 
 type Taxes []Tax
 
+// FindByCode returns the tax with the given tax code
+func (tt Taxes) FindByCode(code string) (Tax, bool) {
+	for _, t := range tt {
+		if t.Code == code {
+			return t, true
+		}
+	}
+	return Tax{}, false
+}
+
+// FindByPct returns the first tax with the given percentage
+func (tt Taxes) FindByPct(pct float64) (Tax, bool) {
+	for _, t := range tt {
+		if t.TaxPct == pct {
+			return t, true
+		}
+	}
+	return Tax{}, false
+}
+
 type Tax struct {
 	ID     string  `json:"Id"`
 	Code   string  `json:"Code"`
 	Name   string  `json:"Name"`
 	TaxPct float64 `json:"TaxPct"`
 }
+
+// TaxID returns the guid of the tax as used in TaxId fields of invoice rows
+func (t Tax) TaxID() uuid.UUID {
+	return uuid.FromStringOrNil(t.ID)
+}
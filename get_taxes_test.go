@@ -1,14 +1,17 @@
 package aktiva_test
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"testing"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
 )
 
 func TestGetTaxes(t *testing.T) {
 	req := client.NewGetTaxesRequest()
-	resp, err := req.Do()
+	resp, err := req.Do(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -16,3 +19,19 @@ func TestGetTaxes(t *testing.T) {
 	b, _ := json.MarshalIndent(resp, "", "  ")
 	log.Println(string(b))
 }
+
+func TestTaxesFindByPct(t *testing.T) {
+	taxes := aktiva.Taxes{
+		{ID: "973a4395-665f-47a6-a5b6-5384dd24f8d0", Code: "20%", TaxPct: 20},
+		{ID: "665f01a4-357a-4a6b-a565-2f17e6e1da13", Code: "9%", TaxPct: 9},
+	}
+
+	tax, ok := taxes.FindByPct(9)
+	if !ok {
+		t.Fatal("expected tax with 9%")
+	}
+
+	if tax.TaxID().String() != "665f01a4-357a-4a6b-a565-2f17e6e1da13" {
+		t.Errorf("unexpected tax id %s", tax.TaxID())
+	}
+}
@@ -0,0 +1,182 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetTrialBalanceRequest() GetTrialBalanceRequest {
+	r := GetTrialBalanceRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetTrialBalanceQueryParams()
+	r.pathParams = r.NewGetTrialBalancePathParams()
+	r.requestBody = r.NewGetTrialBalanceRequestBody()
+	return r
+}
+
+type GetTrialBalanceRequest struct {
+	client      *Client
+	queryParams *GetTrialBalanceQueryParams
+	pathParams  *GetTrialBalancePathParams
+	method      string
+	headers     http.Header
+	requestBody GetTrialBalanceRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetTrialBalanceRequest) Clone() GetTrialBalanceRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetTrialBalanceRequest) NewGetTrialBalanceQueryParams() *GetTrialBalanceQueryParams {
+	return &GetTrialBalanceQueryParams{}
+}
+
+type GetTrialBalanceQueryParams struct {
+}
+
+func (p GetTrialBalanceQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetTrialBalanceRequest) QueryParams() *GetTrialBalanceQueryParams {
+	return r.queryParams
+}
+
+func (r GetTrialBalanceRequest) NewGetTrialBalancePathParams() *GetTrialBalancePathParams {
+	return &GetTrialBalancePathParams{}
+}
+
+type GetTrialBalancePathParams struct {
+}
+
+func (p *GetTrialBalancePathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetTrialBalanceRequest) PathParams() *GetTrialBalancePathParams {
+	return r.pathParams
+}
+
+func (r *GetTrialBalanceRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetTrialBalanceRequest) Method() string {
+	return r.method
+}
+
+func (r GetTrialBalanceRequest) NewGetTrialBalanceRequestBody() GetTrialBalanceRequestBody {
+	return GetTrialBalanceRequestBody{}
+}
+
+type GetTrialBalanceRequestBody struct {
+	PeriodStart Date `json:"PeriodStart"`
+	PeriodEnd   Date `json:"PeriodEnd"`
+	// Limit the report to this department code, all departments when empty
+	DepFilter string `json:"DepFilter,omitempty"`
+}
+
+func (r *GetTrialBalanceRequest) RequestBody() *GetTrialBalanceRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetTrialBalanceRequest) SetRequestBody(body GetTrialBalanceRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetTrialBalanceRequest) NewResponseBody() *GetTrialBalanceResponseBody {
+	return &GetTrialBalanceResponseBody{}
+}
+
+type GetTrialBalanceResponseBody TrialBalanceRows
+
+func (r *GetTrialBalanceRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("gettrialbalancerep", r.PathParams())
+}
+
+func (r *GetTrialBalanceRequest) Do(ctx context.Context, opts ...RequestOption) (GetTrialBalanceResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type TrialBalanceRows []TrialBalanceRow
+
+// TrialBalanceRow is the turnover of an account in the reported period.
+// Balances are positive on the debit side.
+type TrialBalanceRow struct {
+	AccountCode    string  `json:"AccountCode"`
+	AccountName    string  `json:"AccountName"`
+	OpeningBalance Decimal `json:"StartBalance"`
+	Debit          Decimal `json:"DebitAmount"`
+	Credit         Decimal `json:"CreditAmount"`
+	ClosingBalance Decimal `json:"EndBalance"`
+}
+
+// Reconciles reports whether the closing balance equals the opening balance
+// plus the turnover
+func (r TrialBalanceRow) Reconciles() bool {
+	return r.OpeningBalance.Add(r.Debit).Sub(r.Credit).Equal(r.ClosingBalance)
+}
+
+// Balanced reports whether the debit and credit turnover of all accounts are
+// equal
+func (rr TrialBalanceRows) Balanced() bool {
+	debit, credit := Decimal{}, Decimal{}
+	for _, r := range rr {
+		debit = debit.Add(r.Debit)
+		credit = credit.Add(r.Credit)
+	}
+	return debit.Equal(credit)
+}
@@ -0,0 +1,41 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestGetTrialBalance(t *testing.T) {
+	req := client.NewGetTrialBalanceRequest()
+	req.RequestBody().PeriodStart = aktiva.Date{time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	req.RequestBody().PeriodEnd = aktiva.Date{time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)}
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
+
+func TestTrialBalanceRows(t *testing.T) {
+	rows := aktiva.TrialBalanceRows{
+		{AccountCode: "1000", OpeningBalance: aktiva.NewDecimal(100, 0), Debit: aktiva.MustParseDecimal("0.1"), ClosingBalance: aktiva.MustParseDecimal("100.1")},
+		{AccountCode: "3000", Credit: aktiva.MustParseDecimal("0.1"), ClosingBalance: aktiva.MustParseDecimal("-0.1")},
+	}
+
+	for _, r := range rows {
+		if !r.Reconciles() {
+			t.Errorf("expected account %s to reconcile", r.AccountCode)
+		}
+	}
+
+	if !rows.Balanced() {
+		t.Error("expected rows to be balanced")
+	}
+}
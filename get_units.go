@@ -0,0 +1,157 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetUnitsRequest() GetUnitsRequest {
+	r := GetUnitsRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetUnitsQueryParams()
+	r.pathParams = r.NewGetUnitsPathParams()
+	r.requestBody = r.NewGetUnitsRequestBody()
+	return r
+}
+
+type GetUnitsRequest struct {
+	client      *Client
+	queryParams *GetUnitsQueryParams
+	pathParams  *GetUnitsPathParams
+	method      string
+	headers     http.Header
+	requestBody GetUnitsRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetUnitsRequest) Clone() GetUnitsRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetUnitsRequest) NewGetUnitsQueryParams() *GetUnitsQueryParams {
+	return &GetUnitsQueryParams{}
+}
+
+type GetUnitsQueryParams struct {
+}
+
+func (p GetUnitsQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetUnitsRequest) QueryParams() *GetUnitsQueryParams {
+	return r.queryParams
+}
+
+func (r GetUnitsRequest) NewGetUnitsPathParams() *GetUnitsPathParams {
+	return &GetUnitsPathParams{}
+}
+
+type GetUnitsPathParams struct {
+}
+
+func (p *GetUnitsPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetUnitsRequest) PathParams() *GetUnitsPathParams {
+	return r.pathParams
+}
+
+func (r *GetUnitsRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetUnitsRequest) Method() string {
+	return r.method
+}
+
+func (r GetUnitsRequest) NewGetUnitsRequestBody() GetUnitsRequestBody {
+	return GetUnitsRequestBody{}
+}
+
+type GetUnitsRequestBody struct {
+}
+
+func (r *GetUnitsRequest) RequestBody() *GetUnitsRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetUnitsRequest) SetRequestBody(body GetUnitsRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetUnitsRequest) NewResponseBody() *GetUnitsResponseBody {
+	return &GetUnitsResponseBody{}
+}
+
+type GetUnitsResponseBody Units
+
+func (r *GetUnitsRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getunits", r.PathParams())
+}
+
+func (r *GetUnitsRequest) Do(ctx context.Context, opts ...RequestOption) (GetUnitsResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, nil)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type Units []Unit
+
+// Unit is a unit of measure
+type Unit struct {
+	ID   string `json:"Id"`
+	Code string `json:"Code"`
+	Name string `json:"Name"`
+}
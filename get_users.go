@@ -0,0 +1,169 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetUsersRequest() GetUsersRequest {
+	r := GetUsersRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetUsersQueryParams()
+	r.pathParams = r.NewGetUsersPathParams()
+	r.requestBody = r.NewGetUsersRequestBody()
+	return r
+}
+
+type GetUsersRequest struct {
+	client      *Client
+	queryParams *GetUsersQueryParams
+	pathParams  *GetUsersPathParams
+	method      string
+	headers     http.Header
+	requestBody GetUsersRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetUsersRequest) Clone() GetUsersRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetUsersRequest) NewGetUsersQueryParams() *GetUsersQueryParams {
+	return &GetUsersQueryParams{}
+}
+
+type GetUsersQueryParams struct {
+}
+
+func (p GetUsersQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetUsersRequest) QueryParams() *GetUsersQueryParams {
+	return r.queryParams
+}
+
+func (r GetUsersRequest) NewGetUsersPathParams() *GetUsersPathParams {
+	return &GetUsersPathParams{}
+}
+
+type GetUsersPathParams struct {
+}
+
+func (p *GetUsersPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetUsersRequest) PathParams() *GetUsersPathParams {
+	return r.pathParams
+}
+
+func (r *GetUsersRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetUsersRequest) Method() string {
+	return r.method
+}
+
+func (r GetUsersRequest) NewGetUsersRequestBody() GetUsersRequestBody {
+	return GetUsersRequestBody{}
+}
+
+type GetUsersRequestBody struct {
+}
+
+func (r *GetUsersRequest) RequestBody() *GetUsersRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetUsersRequest) SetRequestBody(body GetUsersRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetUsersRequest) NewResponseBody() *GetUsersResponseBody {
+	return &GetUsersResponseBody{}
+}
+
+type GetUsersResponseBody Users
+
+func (r *GetUsersRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getusers", r.PathParams())
+}
+
+func (r *GetUsersRequest) Do(ctx context.Context, opts ...RequestOption) (GetUsersResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, nil)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type Users []User
+
+// User is an Aktiva user, also used as the salesperson on invoices
+type User struct {
+	ID    string `json:"Id"`
+	Name  string `json:"Name"`
+	Email string `json:"Email"`
+}
+
+// FindByEmail returns the user with the e-mail address, compared case
+// insensitively
+func (uu Users) FindByEmail(email string) (User, bool) {
+	for _, u := range uu {
+		if strings.EqualFold(u.Email, email) {
+			return u, true
+		}
+	}
+	return User{}, false
+}
@@ -0,0 +1,154 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetVendorDebtsReportRequest() GetVendorDebtsReportRequest {
+	r := GetVendorDebtsReportRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetVendorDebtsReportQueryParams()
+	r.pathParams = r.NewGetVendorDebtsReportPathParams()
+	r.requestBody = r.NewGetVendorDebtsReportRequestBody()
+	return r
+}
+
+type GetVendorDebtsReportRequest struct {
+	client      *Client
+	queryParams *GetVendorDebtsReportQueryParams
+	pathParams  *GetVendorDebtsReportPathParams
+	method      string
+	headers     http.Header
+	requestBody GetVendorDebtsReportRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetVendorDebtsReportRequest) Clone() GetVendorDebtsReportRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetVendorDebtsReportRequest) NewGetVendorDebtsReportQueryParams() *GetVendorDebtsReportQueryParams {
+	return &GetVendorDebtsReportQueryParams{}
+}
+
+type GetVendorDebtsReportQueryParams struct {
+}
+
+func (p GetVendorDebtsReportQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetVendorDebtsReportRequest) QueryParams() *GetVendorDebtsReportQueryParams {
+	return r.queryParams
+}
+
+func (r GetVendorDebtsReportRequest) NewGetVendorDebtsReportPathParams() *GetVendorDebtsReportPathParams {
+	return &GetVendorDebtsReportPathParams{}
+}
+
+type GetVendorDebtsReportPathParams struct {
+}
+
+func (p *GetVendorDebtsReportPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetVendorDebtsReportRequest) PathParams() *GetVendorDebtsReportPathParams {
+	return r.pathParams
+}
+
+func (r *GetVendorDebtsReportRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetVendorDebtsReportRequest) Method() string {
+	return r.method
+}
+
+func (r GetVendorDebtsReportRequest) NewGetVendorDebtsReportRequestBody() GetVendorDebtsReportRequestBody {
+	return GetVendorDebtsReportRequestBody{}
+}
+
+type GetVendorDebtsReportRequestBody struct {
+	// Limit the report to vendors with this name, all vendors when empty
+	VendName string `json:"VendName,omitempty"`
+	// Only include debts overdue by at least this many days
+	OverDueDays int `json:"OverDueDays,omitempty"`
+	// Debts as of this date
+	DebtDate Date `json:"DebtDate"`
+}
+
+func (r *GetVendorDebtsReportRequest) RequestBody() *GetVendorDebtsReportRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetVendorDebtsReportRequest) SetRequestBody(body GetVendorDebtsReportRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetVendorDebtsReportRequest) NewResponseBody() *GetVendorDebtsReportResponseBody {
+	return &GetVendorDebtsReportResponseBody{}
+}
+
+type GetVendorDebtsReportResponseBody DebtReportRows
+
+func (r *GetVendorDebtsReportRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getvenddebtrep", r.PathParams())
+}
+
+func (r *GetVendorDebtsReportRequest) Do(ctx context.Context, opts ...RequestOption) (GetVendorDebtsReportResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
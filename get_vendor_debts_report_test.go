@@ -0,0 +1,36 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestGetVendorDebtsReport(t *testing.T) {
+	req := client.NewGetVendorDebtsReportRequest()
+	req.RequestBody().DebtDate = aktiva.Date{time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)}
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
+
+func TestDebtReportUnpaidByCurrency(t *testing.T) {
+	rows := aktiva.DebtReportRows{
+		{CurrencyCode: "EUR", UnPaidAmount: aktiva.NewDecimal(100, 0)},
+		{CurrencyCode: "USD", UnPaidAmount: aktiva.NewDecimal(20, 0)},
+		{CurrencyCode: "EUR", UnPaidAmount: aktiva.NewDecimal(50, 0)},
+	}
+
+	totals := rows.UnpaidByCurrency()
+	if totals["EUR"].String() != "150" || totals["USD"].String() != "20" {
+		t.Errorf("unexpected totals: %v", totals)
+	}
+}
@@ -0,0 +1,156 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetVendorGroupsRequest() GetVendorGroupsRequest {
+	r := GetVendorGroupsRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetVendorGroupsQueryParams()
+	r.pathParams = r.NewGetVendorGroupsPathParams()
+	r.requestBody = r.NewGetVendorGroupsRequestBody()
+	return r
+}
+
+type GetVendorGroupsRequest struct {
+	client      *Client
+	queryParams *GetVendorGroupsQueryParams
+	pathParams  *GetVendorGroupsPathParams
+	method      string
+	headers     http.Header
+	requestBody GetVendorGroupsRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetVendorGroupsRequest) Clone() GetVendorGroupsRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetVendorGroupsRequest) NewGetVendorGroupsQueryParams() *GetVendorGroupsQueryParams {
+	return &GetVendorGroupsQueryParams{}
+}
+
+type GetVendorGroupsQueryParams struct {
+}
+
+func (p GetVendorGroupsQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetVendorGroupsRequest) QueryParams() *GetVendorGroupsQueryParams {
+	return r.queryParams
+}
+
+func (r GetVendorGroupsRequest) NewGetVendorGroupsPathParams() *GetVendorGroupsPathParams {
+	return &GetVendorGroupsPathParams{}
+}
+
+type GetVendorGroupsPathParams struct {
+}
+
+func (p *GetVendorGroupsPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetVendorGroupsRequest) PathParams() *GetVendorGroupsPathParams {
+	return r.pathParams
+}
+
+func (r *GetVendorGroupsRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetVendorGroupsRequest) Method() string {
+	return r.method
+}
+
+func (r GetVendorGroupsRequest) NewGetVendorGroupsRequestBody() GetVendorGroupsRequestBody {
+	return GetVendorGroupsRequestBody{}
+}
+
+type GetVendorGroupsRequestBody struct {
+}
+
+func (r *GetVendorGroupsRequest) RequestBody() *GetVendorGroupsRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetVendorGroupsRequest) SetRequestBody(body GetVendorGroupsRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GetVendorGroupsRequest) NewResponseBody() *GetVendorGroupsResponseBody {
+	return &GetVendorGroupsResponseBody{}
+}
+
+type GetVendorGroupsResponseBody VendorGroups
+
+func (r *GetVendorGroupsRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getvendorgroups", r.PathParams())
+}
+
+func (r *GetVendorGroupsRequest) Do(ctx context.Context, opts ...RequestOption) (GetVendorGroupsResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, nil)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type VendorGroups []VendorGroup
+
+type VendorGroup struct {
+	ID   string `json:"Id"`
+	Code string `json:"Code"`
+	Name string `json:"Name"`
+}
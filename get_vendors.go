@@ -0,0 +1,198 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewGetVendorsRequest() GetVendorsRequest {
+	r := GetVendorsRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewGetVendorsQueryParams()
+	r.pathParams = r.NewGetVendorsPathParams()
+	r.requestBody = r.NewGetVendorsRequestBody()
+	return r
+}
+
+type GetVendorsRequest struct {
+	client      *Client
+	queryParams *GetVendorsQueryParams
+	pathParams  *GetVendorsPathParams
+	method      string
+	headers     http.Header
+	requestBody GetVendorsRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r GetVendorsRequest) Clone() GetVendorsRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r GetVendorsRequest) NewGetVendorsQueryParams() *GetVendorsQueryParams {
+	return &GetVendorsQueryParams{}
+}
+
+type GetVendorsQueryParams struct {
+}
+
+func (p GetVendorsQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GetVendorsRequest) QueryParams() *GetVendorsQueryParams {
+	return r.queryParams
+}
+
+func (r GetVendorsRequest) NewGetVendorsPathParams() *GetVendorsPathParams {
+	return &GetVendorsPathParams{}
+}
+
+type GetVendorsPathParams struct {
+}
+
+func (p *GetVendorsPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GetVendorsRequest) PathParams() *GetVendorsPathParams {
+	return r.pathParams
+}
+
+func (r *GetVendorsRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GetVendorsRequest) Method() string {
+	return r.method
+}
+
+func (r GetVendorsRequest) NewGetVendorsRequestBody() GetVendorsRequestBody {
+	return GetVendorsRequestBody{}
+}
+
+type GetVendorsRequestBody VendorsFilter
+
+func (r *GetVendorsRequest) RequestBody() *GetVendorsRequestBody {
+	return &r.requestBody
+}
+
+func (r *GetVendorsRequest) SetRequestBody(body GetVendorsRequestBody) {
+	r.requestBody = body
+}
+
+// SetChangedSince limits the results to records changed after t, for
+// incremental syncs. t is sent in the client's location. The zero time
+// removes the limit.
+func (r *GetVendorsRequest) SetChangedSince(t time.Time) {
+	if t.IsZero() {
+		r.requestBody.ChangedDate = nil
+		return
+	}
+	changed := r.client.DateTime(t)
+	r.requestBody.ChangedDate = &changed
+}
+
+func (r *GetVendorsRequest) NewResponseBody() *GetVendorsResponseBody {
+	return &GetVendorsResponseBody{}
+}
+
+type GetVendorsResponseBody Vendors
+
+func (r *GetVendorsRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("getvendors", r.PathParams())
+}
+
+func (r *GetVendorsRequest) Do(ctx context.Context, opts ...RequestOption) (GetVendorsResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type VendorsFilter struct {
+	ID       *uuid.UUID `json:"Id,omitempty"`
+	Name     string     `json:"Name,omitempty"`
+	RegNo    string     `json:"RegNo,omitempty"`
+	VatRegNo string     `json:"VatRegNo,omitempty"`
+	// Only return records changed after this moment, see SetChangedSince
+	ChangedDate *DateTime `json:"ChangedDate,omitempty"`
+}
+
+type Vendors []Vendor
+
+type Vendor struct {
+	VendorID           string      `json:"VendorId"`
+	Name               string      `json:"Name"`
+	RegNo              string      `json:"RegNo"`
+	VatRegNo           string      `json:"VatRegNo"`
+	Contact            interface{} `json:"Contact"`
+	PhoneNo            string      `json:"PhoneNo"`
+	PhoneNo2           string      `json:"PhoneNo2"`
+	Address            string      `json:"Address"`
+	City               string      `json:"City"`
+	County             string      `json:"County"`
+	PostalCode         string      `json:"PostalCode"`
+	CountryCode        string      `json:"CountryCode"`
+	CountryName        string      `json:"CountryName"`
+	FaxNo              string      `json:"FaxNo"`
+	Email              string      `json:"Email"`
+	HomePage           string      `json:"HomePage"`
+	PaymentDeadLine    int         `json:"PaymentDeadLine"`
+	OverdueCharge      Decimal     `json:"OverdueCharge"`
+	CurrencyCode       string      `json:"CurrencyCode"`
+	VendorGroupName    string      `json:"VendorGroupName"`
+	BankAccount        string      `json:"BankAccount"`
+	ReceiverName       string      `json:"ReceiverName"`
+	ExpenseAccountCode string      `json:"ExpenseAccountCode"`
+}
@@ -4,7 +4,7 @@ require (
 	github.com/Azure/go-ntlmssp v0.0.0-20180810175552-4a21cbd618b4
 	github.com/gofrs/uuid v3.2.0+incompatible
 	github.com/gorilla/schema v0.0.0-20171211162101-9fa3b6af65dc
-	github.com/omniboost/go-exactglobe-webservices v0.0.0-20191220115841-07bd2fdc13d3
+	golang.org/x/crypto v0.0.0-20190122013713-64072686203f // indirect
 	gopkg.in/guregu/null.v3 v3.4.0
 )
 
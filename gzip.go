@@ -0,0 +1,112 @@
+package aktiva
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// SetCompressRequests gzips request bodies. The signature is still calculated
+// over the uncompressed JSON. Only enable it for installations that accept
+// gzipped requests.
+func (c *Client) SetCompressRequests(compress bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.compressRequests = compress
+}
+
+func (c *Client) CompressRequests() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.compressRequests
+}
+
+func gzipBytes(data []byte) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	w := gzip.NewWriter(buf)
+	_, err := w.Write(data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.Close()
+	return buf.Bytes(), err
+}
+
+// setRequestBody sets data as the body of req, gzipped when compress is set
+func setRequestBody(req *http.Request, data []byte, compress bool) error {
+	if compress && len(data) > 0 {
+		gz, err := gzipBytes(data)
+		if err != nil {
+			return err
+		}
+
+		data = gz
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+
+	req.ContentLength = int64(len(data))
+	req.Body = ioutil.NopCloser(bytes.NewReader(data))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(data)), nil
+	}
+
+	if len(data) == 0 {
+		req.Body = http.NoBody
+	}
+	return nil
+}
+
+// requestPayload returns the uncompressed body of req
+func requestPayload(req *http.Request) ([]byte, error) {
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	if req.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		return ioutil.ReadAll(gz)
+	}
+
+	return ioutil.ReadAll(body)
+}
+
+// decompressResponse replaces a gzipped response body with its decompressed
+// content. The standard transport already does this when it added the
+// Accept-Encoding header itself, other round trippers might not.
+func decompressResponse(resp *http.Response) error {
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return nil
+	}
+
+	gz, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	resp.Body = &gzipBody{Reader: gz, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (b *gzipBody) Close() error {
+	b.Reader.Close()
+	return b.body.Close()
+}
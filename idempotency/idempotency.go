@@ -0,0 +1,173 @@
+// Package idempotency prevents duplicate sales and purchase invoices when a
+// send is retried after a timeout or crash: before sending, it looks for an
+// invoice that was already created for the same document and returns that
+// one.
+//
+//	guard := idempotency.New(client, nil)
+//	resp, existing, err := guard.SendInvoice(ctx, req)
+//	resp, existing, err := guard.SendPurchaseInvoice(ctx, purchaseReq)
+//
+// Merit has no idempotency keys, so the check lists the invoices of the day of
+// the document. It isn't atomic: two workers sending the same document at the
+// same time can still both create it.
+package idempotency
+
+import (
+	"context"
+	"strings"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+// MatchFunc reports whether existing was created for invoice
+type MatchFunc func(invoice aktiva.NewInvoice, existing aktiva.Invoice) bool
+
+// MatchNumberDateCustomer matches on invoice number, document date and
+// customer: the customer ID when set, otherwise its name
+func MatchNumberDateCustomer(invoice aktiva.NewInvoice, existing aktiva.Invoice) bool {
+	if invoice.InvoiceNo == "" || invoice.InvoiceNo != existing.InvoiceNo {
+		return false
+	}
+
+	if !sameDay(invoice.DocDate, existing.DocumentDate) {
+		return false
+	}
+
+	if invoice.Customer.ID != nil {
+		return strings.EqualFold(invoice.Customer.ID.String(), existing.CustomerID)
+	}
+	return strings.EqualFold(strings.TrimSpace(invoice.Customer.Name), strings.TrimSpace(existing.CustomerName))
+}
+
+// PurchaseMatchFunc reports whether existing was created for invoice
+type PurchaseMatchFunc func(invoice aktiva.NewPurchaseInvoice, existing aktiva.PurchaseInvoice) bool
+
+// MatchBillNoDateVendor matches on the number of the vendor's bill, document
+// date and vendor: the vendor ID when set, otherwise its name
+func MatchBillNoDateVendor(invoice aktiva.NewPurchaseInvoice, existing aktiva.PurchaseInvoice) bool {
+	if invoice.BillNo == "" || invoice.BillNo != existing.BillNo {
+		return false
+	}
+
+	if !sameDay(invoice.DocDate, existing.DocumentDate) {
+		return false
+	}
+
+	if invoice.Vendor.ID != nil {
+		return strings.EqualFold(invoice.Vendor.ID.String(), existing.VendorID)
+	}
+	return strings.EqualFold(strings.TrimSpace(invoice.Vendor.Name), strings.TrimSpace(existing.VendorName))
+}
+
+func sameDay(a, b aktiva.Date) bool {
+	y1, m1, d1 := a.Date()
+	y2, m2, d2 := b.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
+
+// Guard checks for existing invoices before sending new ones
+type Guard struct {
+	client        *aktiva.Client
+	match         MatchFunc
+	matchPurchase PurchaseMatchFunc
+}
+
+// New returns a guard using match to recognize existing sales invoices,
+// MatchNumberDateCustomer when nil. Purchase invoices are recognized with
+// MatchBillNoDateVendor, see SetPurchaseMatch.
+func New(client *aktiva.Client, match MatchFunc) *Guard {
+	if match == nil {
+		match = MatchNumberDateCustomer
+	}
+	return &Guard{client: client, match: match, matchPurchase: MatchBillNoDateVendor}
+}
+
+// SetPurchaseMatch sets the function recognizing existing purchase invoices,
+// nil restores MatchBillNoDateVendor
+func (g *Guard) SetPurchaseMatch(match PurchaseMatchFunc) {
+	if match == nil {
+		match = MatchBillNoDateVendor
+	}
+	g.matchPurchase = match
+}
+
+// Find returns the existing invoice created for invoice, false when there is
+// none
+func (g *Guard) Find(ctx context.Context, invoice aktiva.NewInvoice) (aktiva.Invoice, bool, error) {
+	req := g.client.NewGetInvoicesRequest()
+	req.RequestBody().PeriodStart = invoice.DocDate
+	req.RequestBody().PeriodEnd = invoice.DocDate
+
+	invoices, err := req.Do(ctx)
+	if err != nil {
+		return aktiva.Invoice{}, false, err
+	}
+
+	for _, existing := range invoices {
+		if g.match(invoice, existing) {
+			return existing, true, nil
+		}
+	}
+	return aktiva.Invoice{}, false, nil
+}
+
+// SendInvoice sends req unless an invoice for it already exists. The
+// response of an existing invoice only has the IDs and numbers filled, and
+// the returned bool is true.
+func (g *Guard) SendInvoice(ctx context.Context, req aktiva.SendInvoiceRequest, opts ...aktiva.RequestOption) (aktiva.SendInvoiceResponseBody, bool, error) {
+	existing, ok, err := g.Find(ctx, aktiva.NewInvoice(*req.RequestBody()))
+	if err != nil {
+		return aktiva.SendInvoiceResponseBody{}, false, err
+	}
+
+	if ok {
+		return aktiva.SendInvoiceResponseBody{
+			CustomerID: existing.CustomerID,
+			InvoiceID:  existing.SIHID,
+			InvoiceNo:  existing.InvoiceNo,
+		}, true, nil
+	}
+
+	resp, err := req.Do(ctx, opts...)
+	return resp, false, err
+}
+
+// FindPurchase returns the existing purchase invoice created for invoice,
+// false when there is none
+func (g *Guard) FindPurchase(ctx context.Context, invoice aktiva.NewPurchaseInvoice) (aktiva.PurchaseInvoice, bool, error) {
+	req := g.client.NewGetPurchaseInvoicesRequest()
+	req.RequestBody().PeriodStart = invoice.DocDate
+	req.RequestBody().PeriodEnd = invoice.DocDate
+
+	invoices, err := req.Do(ctx)
+	if err != nil {
+		return aktiva.PurchaseInvoice{}, false, err
+	}
+
+	for _, existing := range invoices {
+		if g.matchPurchase(invoice, existing) {
+			return existing, true, nil
+		}
+	}
+	return aktiva.PurchaseInvoice{}, false, nil
+}
+
+// SendPurchaseInvoice sends req unless a purchase invoice for it already
+// exists. The response of an existing invoice only has the IDs filled, and
+// the returned bool is true.
+func (g *Guard) SendPurchaseInvoice(ctx context.Context, req aktiva.SendPurchaseInvoiceRequest, opts ...aktiva.RequestOption) (aktiva.SendPurchaseInvoiceResponseBody, bool, error) {
+	existing, ok, err := g.FindPurchase(ctx, aktiva.NewPurchaseInvoice(*req.RequestBody()))
+	if err != nil {
+		return aktiva.SendPurchaseInvoiceResponseBody{}, false, err
+	}
+
+	if ok {
+		return aktiva.SendPurchaseInvoiceResponseBody{
+			VendorID: existing.VendorID,
+			BillID:   existing.PIHID,
+		}, true, nil
+	}
+
+	resp, err := req.Do(ctx, opts...)
+	return resp, false, err
+}
@@ -0,0 +1,111 @@
+package idempotency_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+	"github.com/omniboost/go-merit-aktiva/idempotency"
+)
+
+func TestSendInvoice(t *testing.T) {
+	sent := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch path.Base(r.URL.Path) {
+		case "getinvoices":
+			w.Write([]byte(`[{"SIHId": "e0f3c1a2", "InvoiceNo": "INV-1", "DocumentDate": "20200301", "CustomerName": "Omniboost B.V."}]`))
+		case "sendinvoice":
+			sent++
+			w.Write([]byte(`{"InvoiceId": "new", "InvoiceNo": "INV-2"}`))
+		}
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+	guard := idempotency.New(c, nil)
+
+	for _, tt := range []struct {
+		no       string
+		existing bool
+		id       string
+	}{
+		{"INV-1", true, "e0f3c1a2"},
+		{"INV-2", false, "new"},
+	} {
+		req := c.NewSendInvoiceRequest()
+		req.RequestBody().InvoiceNo = tt.no
+		req.RequestBody().DocDate = aktiva.Date{Time: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)}
+		req.RequestBody().Customer.Name = "Omniboost B.V."
+
+		resp, existing, err := guard.SendInvoice(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if existing != tt.existing || resp.InvoiceID != tt.id {
+			t.Errorf("%s: expected %v/%s, got %v/%s", tt.no, tt.existing, tt.id, existing, resp.InvoiceID)
+		}
+	}
+
+	if sent != 1 {
+		t.Errorf("expected 1 invoice to be sent, got %d", sent)
+	}
+}
+
+func TestSendPurchaseInvoice(t *testing.T) {
+	sent := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch path.Base(r.URL.Path) {
+		case "getpurchorders":
+			w.Write([]byte(`[{"PIHId": "b7d2a9e4", "BillNo": "B-1", "DocumentDate": "20200301", "VendorName": "Omniboost B.V."}]`))
+		case "sendpurchinvoice":
+			sent++
+			w.Write([]byte(`{"BillId": "new"}`))
+		}
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+	guard := idempotency.New(c, nil)
+
+	for _, tt := range []struct {
+		no       string
+		vendor   string
+		existing bool
+		id       string
+	}{
+		{"B-1", "Omniboost B.V.", true, "b7d2a9e4"},
+		// bill numbers are only unique per vendor
+		{"B-1", "Other B.V.", false, "new"},
+		{"B-2", "Omniboost B.V.", false, "new"},
+	} {
+		req := c.NewSendPurchaseInvoiceRequest()
+		req.RequestBody().BillNo = tt.no
+		req.RequestBody().DocDate = aktiva.Date{Time: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)}
+		req.RequestBody().Vendor.Name = tt.vendor
+
+		resp, existing, err := guard.SendPurchaseInvoice(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if existing != tt.existing || resp.BillID != tt.id {
+			t.Errorf("%s/%s: expected %v/%s, got %v/%s", tt.no, tt.vendor, tt.existing, tt.id, existing, resp.BillID)
+		}
+	}
+
+	if sent != 2 {
+		t.Errorf("expected 2 invoices to be sent, got %d", sent)
+	}
+}
@@ -0,0 +1,98 @@
+// Package testrun records what an example or end-to-end test run creates in a
+// Merit Aktiva demo company, so aktiva-cleanup can delete exactly those
+// documents afterwards instead of guessing them from their numbers.
+package testrun
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Kind is the type of a recorded entity
+type Kind string
+
+const (
+	KindInvoice  Kind = "invoice"
+	KindGLBatch  Kind = "glbatch"
+	KindItem     Kind = "item"
+	KindCustomer Kind = "customer"
+)
+
+// Entity is a single record created by a run
+type Entity struct {
+	Kind Kind   `json:"kind"`
+	ID   string `json:"id"`
+	// Number or code the entity was created with: the invoice number, the
+	// batch code and number of a GL batch ("GL12"), the item code or the
+	// customer name. Checked against the company before deleting.
+	Key string `json:"key"`
+}
+
+// Run lists the entities created by one run, in creation order
+type Run struct {
+	ID      string    `json:"id"`
+	Started time.Time `json:"started"`
+	Created []Entity  `json:"created"`
+
+	path string
+}
+
+// New starts a run recorded in dir. The run ID is unique per second, runs use
+// it as suffix of the numbers and codes they create.
+func New(dir string) *Run {
+	r := &Run{
+		ID:      time.Now().Format("20060102150405"),
+		Started: time.Now(),
+		Created: []Entity{},
+	}
+	r.path = filepath.Join(dir, fmt.Sprintf("run-%s.json", r.ID))
+	return r
+}
+
+// Load reads the run recorded in path
+func Load(path string) (*Run, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Run{path: path}
+	err = json.Unmarshal(b, r)
+	if err != nil {
+		return nil, fmt.Errorf("reading run %s: %w", path, err)
+	}
+	return r, nil
+}
+
+// Path returns the file the run is recorded in
+func (r *Run) Path() string {
+	return r.path
+}
+
+// Add records an entity and saves the run right away, so the record is
+// complete even when a later step of the run fails
+func (r *Run) Add(kind Kind, id, key string) error {
+	r.Created = append(r.Created, Entity{Kind: kind, ID: id, Key: key})
+	return r.Save()
+}
+
+// Save writes the run to its file. A run without entities removes the file.
+func (r *Run) Save() error {
+	if len(r.Created) == 0 {
+		err := os.Remove(r.path)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, b, 0644)
+}
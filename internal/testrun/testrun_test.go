@@ -0,0 +1,40 @@
+package testrun_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/omniboost/go-merit-aktiva/internal/testrun"
+)
+
+func TestRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testrun")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	run := testrun.New(dir)
+	err = run.Add(testrun.KindInvoice, "e0f3c1a2", "EX-"+run.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := testrun.Load(run.Path())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.ID != run.ID || len(loaded.Created) != 1 || loaded.Created[0].Key != "EX-"+run.ID {
+		t.Errorf("unexpected run %+v", loaded)
+	}
+
+	loaded.Created = nil
+	err = loaded.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(run.Path()); !os.IsNotExist(err) {
+		t.Errorf("expected the empty run to be removed, got %v", err)
+	}
+}
@@ -0,0 +1,125 @@
+package aktiva
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// ErrIteratorDone is returned by Next when all results are returned
+var ErrIteratorDone = errors.New("no more results")
+
+// Iterator walks over the results of a list endpoint with a PeriodStart and
+// PeriodEnd filter. The period is requested in windows of MaxPeriodMonths:
+// the next window is only fetched when Next has returned all results of the
+// current one, so only that window is kept in memory. Results are decoded one
+// by one when Next is called.
+type Iterator struct {
+	client   *Client
+	endpoint string
+	// start of the next window to fetch, the period is done when it's after
+	// end
+	next time.Time
+	end  time.Time
+	page []json.RawMessage
+}
+
+// NewIterator returns an iterator over the results of endpoint, e.g.
+// "getglbatches", from start to end (inclusive)
+func (c *Client) NewIterator(endpoint string, start, end time.Time) *Iterator {
+	return &Iterator{
+		client:   c,
+		endpoint: endpoint,
+		next:     start,
+		end:      end,
+	}
+}
+
+// Next decodes the next result into v. It returns ErrIteratorDone when there
+// are no more results.
+func (it *Iterator) Next(ctx context.Context, v interface{}) error {
+	for len(it.page) == 0 {
+		if it.end.Before(it.next) {
+			return ErrIteratorDone
+		}
+
+		next := it.next.AddDate(0, MaxPeriodMonths, 0)
+		w := DateWindow{Start: it.next, End: next.AddDate(0, 0, -1)}
+		if w.End.After(it.end) {
+			w.End = it.end
+		}
+
+		err := it.fetch(ctx, w)
+		if err != nil {
+			return err
+		}
+		it.next = next
+	}
+
+	raw := it.page[0]
+	it.page = it.page[1:]
+
+	// decode like the client decodes responses
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if it.client.DisallowUnknownFields() {
+		dec.DisallowUnknownFields()
+	}
+	return dec.Decode(v)
+}
+
+func (it *Iterator) fetch(ctx context.Context, w DateWindow) error {
+	body := struct {
+		PeriodStart Date
+		PeriodEnd   Date
+	}{
+		PeriodStart: Date{w.Start},
+		PeriodEnd:   Date{w.End},
+	}
+
+	u, err := it.client.GetEndpointURL(it.endpoint, noPathParams{})
+	if err != nil {
+		return err
+	}
+
+	req, err := it.client.NewRequest(ctx, http.MethodGet, u, &body)
+	if err != nil {
+		return err
+	}
+
+	page := []json.RawMessage{}
+	_, err = it.client.Do(req, &page)
+	if err != nil {
+		return err
+	}
+
+	it.page = page
+	return nil
+}
+
+type noPathParams struct{}
+
+func (noPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+// GLTransactionIterator walks over the GL transactions of a period
+type GLTransactionIterator struct {
+	it *Iterator
+}
+
+// NewGLTransactionIterator returns an iterator over the GL transactions from
+// start to end (inclusive)
+func (c *Client) NewGLTransactionIterator(start, end time.Time) *GLTransactionIterator {
+	return &GLTransactionIterator{it: c.NewIterator("gettransactions", start, end)}
+}
+
+// Next returns the next transaction, ErrIteratorDone when there are no more
+// transactions
+func (it *GLTransactionIterator) Next(ctx context.Context) (GLTransaction, error) {
+	t := GLTransaction{}
+	err := it.it.Next(ctx, &t)
+	return t, err
+}
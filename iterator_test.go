@@ -0,0 +1,125 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestGLTransactionIterator(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body := struct {
+			PeriodStart string
+		}{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		w.Header().Set("Content-Type", "application/json")
+		if body.PeriodStart == "20200401" {
+			// empty window in between
+			w.Write([]byte(`[]`))
+			return
+		}
+		w.Write([]byte(`[{"GLBId": "` + body.PeriodStart + `-1"}, {"GLBId": "` + body.PeriodStart + `-2"}]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	it := c.NewGLTransactionIterator(start, start.AddDate(0, 9, -1))
+
+	ids := []string{}
+	for {
+		tr, err := it.Next(context.Background())
+		if err == aktiva.ErrIteratorDone {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, tr.GLBID)
+	}
+
+	expected := []string{"20200101-1", "20200101-2", "20200701-1", "20200701-2"}
+	if len(ids) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, ids)
+	}
+	for i := range ids {
+		if ids[i] != expected[i] {
+			t.Errorf("expected %s, got %s", expected[i], ids[i])
+		}
+	}
+
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestIteratorFetchesLazily(t *testing.T) {
+	periods := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := struct {
+			PeriodStart string
+		}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		periods = append(periods, body.PeriodStart)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"GLBId": "` + body.PeriodStart + `"}]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	it := c.NewGLTransactionIterator(start, start.AddDate(2, 0, -1))
+
+	for i, expected := range []string{"20200101", "20200401", "20200701"} {
+		tr, err := it.Next(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tr.GLBID != expected {
+			t.Errorf("expected %s, got %s", expected, tr.GLBID)
+		}
+		if len(periods) != i+1 {
+			t.Fatalf("expected %d requests after %d results, got %v", i+1, i+1, periods)
+		}
+	}
+}
+
+func TestIteratorDisallowUnknownFields(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"GLBId": "1", "Unknown": true}]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err := c.NewGLTransactionIterator(start, start).Next(context.Background())
+	if err != nil {
+		t.Fatalf("expected unknown fields to be ignored, got %s", err)
+	}
+
+	c.SetDisallowUnknownFields(true)
+	_, err = c.NewGLTransactionIterator(start, start).Next(context.Background())
+	if err == nil {
+		t.Fatal("expected an error for the unknown field")
+	}
+}
@@ -0,0 +1,202 @@
+// Package lint scans a Merit Aktiva company through the read endpoints for
+// common data quality issues. It's meant for onboarding new clients: the
+// resulting report lists every issue found and a score between 0 and 100.
+package lint
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+type Severity int
+
+const (
+	SeverityWarning Severity = iota
+	SeverityError
+)
+
+func (s Severity) String() string {
+	if s == SeverityError {
+		return "error"
+	}
+	return "warning"
+}
+
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Issue is a single problem found in the books
+type Issue struct {
+	Check    string   `json:"check"`
+	Severity Severity `json:"severity"`
+	// Kind and ID of the offending record
+	Object   string `json:"object"`
+	ObjectID string `json:"object_id"`
+	Message  string `json:"message"`
+}
+
+// Report is the outcome of a lint run
+type Report struct {
+	// Number of records that were checked
+	Checked int `json:"checked"`
+	// Number of checked records without issues
+	Clean int `json:"clean"`
+	// Percentage of clean records
+	Score  float64 `json:"score"`
+	Issues []Issue `json:"issues"`
+}
+
+func (r *Report) add(checked int, issues []Issue) {
+	affected := map[string]bool{}
+	for _, i := range issues {
+		affected[i.Object+"/"+i.ObjectID] = true
+	}
+
+	r.Checked = r.Checked + checked
+	r.Clean = r.Clean + checked - len(affected)
+	r.Issues = append(r.Issues, issues...)
+
+	r.Score = 100
+	if r.Checked > 0 {
+		r.Score = float64(r.Clean) * 100 / float64(r.Checked)
+	}
+}
+
+// Linter runs all checks against one company
+type Linter struct {
+	client *aktiva.Client
+
+	// GL batches in this period are checked
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+}
+
+func New(client *aktiva.Client) *Linter {
+	end := time.Now()
+	return &Linter{
+		client:      client,
+		PeriodStart: end.AddDate(0, -3, 0),
+		PeriodEnd:   end,
+	}
+}
+
+// Run fetches the customers, vendors, items and GL batches of the company and
+// checks them
+func (l *Linter) Run(ctx context.Context) (Report, error) {
+	report := Report{Issues: []Issue{}, Score: 100}
+
+	customersReq := l.client.NewGetCustomersRequest()
+	customers, err := customersReq.Do(ctx)
+	if err != nil {
+		return report, err
+	}
+	report.add(len(customers), CheckCustomers(aktiva.Customers(customers)))
+
+	vendorsReq := l.client.NewGetVendorsRequest()
+	vendors, err := vendorsReq.Do(ctx)
+	if err != nil {
+		return report, err
+	}
+	report.add(len(vendors), CheckVendors(aktiva.Vendors(vendors)))
+
+	itemsReq := l.client.NewGetItemsRequest()
+	items, err := itemsReq.Do(ctx)
+	if err != nil {
+		return report, err
+	}
+	report.add(len(items), CheckItems(aktiva.Items(items)))
+
+	batchesReq := l.client.NewGetGLBatchesRequest()
+	batchesReq.RequestBody().PeriodStart = aktiva.Date{Time: l.PeriodStart}
+	batchesReq.RequestBody().PeriodEnd = aktiva.Date{Time: l.PeriodEnd}
+	batches, err := batchesReq.Do(ctx)
+	if err != nil {
+		return report, err
+	}
+	report.add(len(batches), CheckGLBatches(batches))
+
+	return report, nil
+}
+
+// CheckCustomers reports customers without registration code or country
+func CheckCustomers(customers aktiva.Customers) []Issue {
+	issues := []Issue{}
+	for _, c := range customers {
+		if strings.TrimSpace(c.RegNo) == "" && !c.NotTDCustomer {
+			issues = append(issues, Issue{
+				Check:    "customer-regno",
+				Severity: SeverityError,
+				Object:   "customer",
+				ObjectID: c.CustomerID,
+				Message:  fmt.Sprintf("customer \"%s\" has no registration code", c.Name),
+			})
+		}
+
+		if strings.TrimSpace(c.CountryCode) == "" {
+			issues = append(issues, Issue{
+				Check:    "customer-country",
+				Severity: SeverityWarning,
+				Object:   "customer",
+				ObjectID: c.CustomerID,
+				Message:  fmt.Sprintf("customer \"%s\" has no country", c.Name),
+			})
+		}
+	}
+	return issues
+}
+
+// CheckVendors reports vendors without registration code
+func CheckVendors(vendors aktiva.Vendors) []Issue {
+	issues := []Issue{}
+	for _, v := range vendors {
+		if strings.TrimSpace(v.RegNo) == "" {
+			issues = append(issues, Issue{
+				Check:    "vendor-regno",
+				Severity: SeverityError,
+				Object:   "vendor",
+				ObjectID: v.VendorID,
+				Message:  fmt.Sprintf("vendor \"%s\" has no registration code", v.Name),
+			})
+		}
+	}
+	return issues
+}
+
+// CheckItems reports items without sales account
+func CheckItems(items aktiva.Items) []Issue {
+	issues := []Issue{}
+	for _, i := range items {
+		if strings.TrimSpace(i.SalesAccountCode) == "" {
+			issues = append(issues, Issue{
+				Check:    "item-sales-account",
+				Severity: SeverityWarning,
+				Object:   "item",
+				ObjectID: i.ItemID,
+				Message:  fmt.Sprintf("item \"%s\" has no sales account", i.Code),
+			})
+		}
+	}
+	return issues
+}
+
+// CheckGLBatches reports foreign currency batches without exchange rate
+func CheckGLBatches(batches aktiva.GetGLBatchesResponseBody) []Issue {
+	issues := []Issue{}
+	for _, b := range batches {
+		if b.CurrencyCode != "" && b.CurrencyRate.IsZero() {
+			issues = append(issues, Issue{
+				Check:    "glbatch-currency-rate",
+				Severity: SeverityError,
+				Object:   "glbatch",
+				ObjectID: b.GLBID,
+				Message:  fmt.Sprintf("batch %s%d in %s has no currency rate", b.BatchCode, b.No, b.CurrencyCode),
+			})
+		}
+	}
+	return issues
+}
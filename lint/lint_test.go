@@ -0,0 +1,25 @@
+package lint_test
+
+import (
+	"testing"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+	"github.com/omniboost/go-merit-aktiva/lint"
+)
+
+func TestCheckCustomers(t *testing.T) {
+	customers := aktiva.Customers{
+		{CustomerID: "1", Name: "Omniboost B.V.", RegNo: "1122334455", CountryCode: "NL"},
+		{CustomerID: "2", Name: "Leon Bogaert", NotTDCustomer: true, CountryCode: "NL"},
+		{CustomerID: "3", Name: "No Reg Code OÜ", CountryCode: "EE"},
+	}
+
+	issues := lint.CheckCustomers(customers)
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue, got %d", len(issues))
+	}
+
+	if issues[0].ObjectID != "3" || issues[0].Check != "customer-regno" {
+		t.Errorf("unexpected issue: %+v", issues[0])
+	}
+}
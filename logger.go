@@ -0,0 +1,107 @@
+package aktiva
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// Logger receives the debug dumps and retry messages of the client. Args are
+// alternating keys and values. A *slog.Logger satisfies the interface.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// LogLevel is the minimum level a StdLogger writes
+type LogLevel int
+
+const (
+	LogLevelDebug LogLevel = -4
+	LogLevelInfo  LogLevel = 0
+	LogLevelWarn  LogLevel = 4
+	LogLevelError LogLevel = 8
+)
+
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelDebug:
+		return "DEBUG"
+	case LogLevelInfo:
+		return "INFO"
+	case LogLevelWarn:
+		return "WARN"
+	case LogLevelError:
+		return "ERROR"
+	}
+	return fmt.Sprintf("LogLevel(%d)", int(l))
+}
+
+// StdLogger writes messages of at least Level to a standard library logger
+type StdLogger struct {
+	Logger *log.Logger
+	Level  LogLevel
+}
+
+// NewStdLogger writes to l, the standard logger when nil
+func NewStdLogger(l *log.Logger, level LogLevel) *StdLogger {
+	return &StdLogger{Logger: l, Level: level}
+}
+
+func (l *StdLogger) Debug(msg string, args ...interface{}) { l.log(LogLevelDebug, msg, args) }
+func (l *StdLogger) Info(msg string, args ...interface{})  { l.log(LogLevelInfo, msg, args) }
+func (l *StdLogger) Warn(msg string, args ...interface{})  { l.log(LogLevelWarn, msg, args) }
+func (l *StdLogger) Error(msg string, args ...interface{}) { l.log(LogLevelError, msg, args) }
+
+func (l *StdLogger) log(level LogLevel, msg string, args []interface{}) {
+	if level < l.Level {
+		return
+	}
+
+	b := &strings.Builder{}
+	b.WriteString(level.String())
+	b.WriteString(" ")
+	b.WriteString(msg)
+	for i := 0; i < len(args); i += 2 {
+		if i+1 == len(args) {
+			fmt.Fprintf(b, " %v", args[i])
+			break
+		}
+		fmt.Fprintf(b, " %v=%v", args[i], args[i+1])
+	}
+
+	if l.Logger == nil {
+		log.Println(b.String())
+		return
+	}
+	l.Logger.Println(b.String())
+}
+
+// nopLogger drops all messages
+type nopLogger struct{}
+
+func (nopLogger) Debug(msg string, args ...interface{}) {}
+func (nopLogger) Info(msg string, args ...interface{})  {}
+func (nopLogger) Warn(msg string, args ...interface{})  {}
+func (nopLogger) Error(msg string, args ...interface{}) {}
+
+// Logger returns the logger of the client. By default the standard logger
+// receives everything from the debug level up.
+func (c *Client) Logger() Logger {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.logger
+}
+
+// SetLogger replaces the logger of the client, nil discards all messages
+func (c *Client) SetLogger(logger Logger) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if logger == nil {
+		logger = nopLogger{}
+	}
+	c.logger = logger
+}
@@ -0,0 +1,117 @@
+package aktiva
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// RequestMetrics describes a single attempt of a request. A retried request
+// is observed once per attempt.
+type RequestMetrics struct {
+	Endpoint  string
+	RequestID string
+	// StatusCode is zero when no response was received
+	StatusCode int
+	// Duration includes waiting for the rate limiter of the client
+	Duration time.Duration
+	// BytesSent is the length of the request body as sent
+	BytesSent int64
+	// BytesReceived is the length of the response body that was read, after
+	// decompression
+	BytesReceived int64
+	// RateLimited is set when the API answered with 429 Too Many Requests
+	RateLimited bool
+	Err         error
+}
+
+// MetricsCollector receives the metrics of every request attempt, for
+// example to update Prometheus counters and histograms. It's called from the
+// goroutines making the requests.
+type MetricsCollector interface {
+	ObserveRequest(m RequestMetrics)
+}
+
+// MetricsCollectorFunc is an adapter to use an ordinary function as a
+// MetricsCollector
+type MetricsCollectorFunc func(m RequestMetrics)
+
+func (f MetricsCollectorFunc) ObserveRequest(m RequestMetrics) {
+	f(m)
+}
+
+func (c *Client) SetMetricsCollector(collector MetricsCollector) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.metricsCollector = collector
+}
+
+func (c *Client) MetricsCollector() MetricsCollector {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.metricsCollector
+}
+
+// observedDo calls do and reports the attempt to the metrics collector
+func (c *Client) observedDo(req *http.Request, responseBody interface{}) (*http.Response, error) {
+	collector := c.MetricsCollector()
+	if collector == nil {
+		return c.do(req, responseBody)
+	}
+
+	start := time.Now()
+	httpResp, err := c.do(req, responseBody)
+
+	m := RequestMetrics{
+		Endpoint:  c.Endpoint(*req.URL),
+		RequestID: RequestID(req),
+		Duration:  time.Since(start),
+		BytesSent: req.ContentLength,
+		Err:       err,
+	}
+	if httpResp != nil {
+		m.StatusCode = httpResp.StatusCode
+		m.BytesReceived = bytesRead(httpResp.Body)
+	}
+
+	rateLimitErr := &RateLimitError{}
+	m.RateLimited = m.StatusCode == http.StatusTooManyRequests || errors.As(err, &rateLimitErr)
+
+	collector.ObserveRequest(m)
+	return httpResp, err
+}
+
+// countingBody counts the bytes read from a response body
+type countingBody struct {
+	io.ReadCloser
+	n int64
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	atomic.AddInt64(&b.n, int64(n))
+	return n, err
+}
+
+// replayedBody is the copy of a body that was already read by CheckResponse.
+// It reports the bytes read from, and closes, the original body.
+type replayedBody struct {
+	io.Reader
+	original *countingBody
+}
+
+func (b *replayedBody) Close() error {
+	return b.original.Close()
+}
+
+func bytesRead(body io.ReadCloser) int64 {
+	switch b := body.(type) {
+	case *countingBody:
+		return atomic.LoadInt64(&b.n)
+	case *replayedBody:
+		return atomic.LoadInt64(&b.original.n)
+	}
+	return 0
+}
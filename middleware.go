@@ -0,0 +1,35 @@
+package aktiva
+
+import "net/http"
+
+// HandlerFunc sends a request and decodes the response into responseBody
+type HandlerFunc func(req *http.Request, responseBody interface{}) (*http.Response, error)
+
+// Middleware wraps the execution of requests by Do. It can change the request
+// before calling next and inspect the response and decoded responseBody after
+// it returns. Retries happen inside next.
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// Use appends middleware to the chain of the client. The first middleware
+// added is the outermost.
+func (c *Client) Use(middleware ...Middleware) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	chain := make([]Middleware, 0, len(c.middleware)+len(middleware))
+	chain = append(chain, c.middleware...)
+	c.middleware = append(chain, middleware...)
+}
+
+// handler returns doWithRetry wrapped in the middleware of the client
+func (c *Client) handler() HandlerFunc {
+	c.mu.RLock()
+	middleware := c.middleware
+	c.mu.RUnlock()
+
+	h := HandlerFunc(c.doWithRetry)
+	for i := len(middleware) - 1; i >= 0; i-- {
+		h = middleware[i](h)
+	}
+	return h
+}
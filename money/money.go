@@ -0,0 +1,324 @@
+// Package money formats amounts the way Estonian, Finnish and Polish invoices
+// print them, including the total in words.
+package money
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+const (
+	LocaleEE = "et-EE"
+	LocaleFI = "fi-FI"
+	LocalePL = "pl-PL"
+)
+
+// non-breaking space, used as thousands separator
+const nbsp = "\u00a0"
+
+var currencySymbols = map[string]string{
+	"EUR": "€",
+	"PLN": "zł",
+	"USD": "$",
+	"GBP": "£",
+	"SEK": "kr",
+}
+
+// Format formats amount with two decimals, the thousands and decimal
+// separators of locale and the currency symbol after the amount:
+// 1 234,56 €. Unknown currencies are printed as their ISO code.
+func Format(amount aktiva.Decimal, currency, locale string) string {
+	symbol, ok := currencySymbols[strings.ToUpper(currency)]
+	if !ok {
+		symbol = strings.ToUpper(currency)
+	}
+
+	return FormatNumber(amount, locale) + nbsp + symbol
+}
+
+// FormatNumber formats amount with two decimals and the thousands and decimal
+// separators of locale. All supported locales use a space for thousands and a
+// comma for decimals; unknown locales fall back to 1,234.56.
+func FormatNumber(amount aktiva.Decimal, locale string) string {
+	thousands, decimal := nbsp, ","
+	switch locale {
+	case LocaleEE, LocaleFI, LocalePL:
+	default:
+		thousands, decimal = ",", "."
+	}
+
+	sign := ""
+	if amount.Round(2).Sign() < 0 {
+		sign = "-"
+	}
+	units, cents := splitCents(amount)
+
+	groups := []string{}
+	for len(units) > 3 {
+		groups = append([]string{units[len(units)-3:]}, groups...)
+		units = units[:len(units)-3]
+	}
+	groups = append([]string{units}, groups...)
+
+	return sign + strings.Join(groups, thousands) + decimal + cents
+}
+
+// splitCents returns the whole units and the two digit cents of the absolute
+// value of amount
+func splitCents(amount aktiva.Decimal) (string, string) {
+	s := amount.Abs().StringFixed(2)
+	i := strings.IndexByte(s, '.')
+	return s[:i], s[i+1:]
+}
+
+// AmountInWords returns the amount as printed on invoices: the whole units in
+// words followed by the currency code and the cents as a fraction, e.g.
+// "sada kakskümmend kolm EUR 45/100".
+func AmountInWords(amount aktiva.Decimal, currency, locale string) (string, error) {
+	units, cents := splitCents(amount)
+	n, err := strconv.ParseInt(units, 10, 64)
+	if err != nil {
+		return "", err
+	}
+
+	words, err := Words(n, locale)
+	if err != nil {
+		return "", err
+	}
+
+	if amount.Round(2).Sign() < 0 {
+		words = minus[locale] + " " + words
+	}
+
+	return fmt.Sprintf("%s %s %s/100", words, strings.ToUpper(currency), cents), nil
+}
+
+var minus = map[string]string{
+	LocaleEE: "miinus",
+	LocaleFI: "miinus",
+	LocalePL: "minus",
+}
+
+// Words spells out a non-negative whole number in the language of locale
+func Words(n int64, locale string) (string, error) {
+	if n < 0 {
+		return "", fmt.Errorf("can't spell negative number %d", n)
+	}
+
+	if n >= 1e12 {
+		return "", fmt.Errorf("can't spell numbers of a trillion and more: %d", n)
+	}
+
+	switch locale {
+	case LocaleEE:
+		return wordsEE(n), nil
+	case LocaleFI:
+		return wordsFI(n), nil
+	case LocalePL:
+		return wordsPL(n), nil
+	}
+
+	return "", fmt.Errorf("unsupported locale \"%s\"", locale)
+}
+
+// split returns the billions, millions, thousands and the rest of n
+func split(n int64) (int64, int64, int64, int64) {
+	return n / 1e9, n / 1e6 % 1000, n / 1000 % 1000, n % 1000
+}
+
+var (
+	onesEE = []string{"null", "üks", "kaks", "kolm", "neli", "viis", "kuus", "seitse", "kaheksa", "üheksa"}
+)
+
+func wordsEE(n int64) string {
+	if n == 0 {
+		return onesEE[0]
+	}
+
+	words := []string{}
+	billions, millions, thousands, rest := split(n)
+	for _, g := range []struct {
+		n        int64
+		one      string
+		multiple string
+	}{
+		{billions, "miljard", "miljardit"},
+		{millions, "miljon", "miljonit"},
+		{thousands, "tuhat", "tuhat"},
+	} {
+		switch {
+		case g.n == 1:
+			words = append(words, g.one)
+		case g.n > 1:
+			words = append(words, hundredsEE(g.n), g.multiple)
+		}
+	}
+
+	if rest > 0 {
+		words = append(words, hundredsEE(rest))
+	}
+
+	return strings.Join(words, " ")
+}
+
+func hundredsEE(n int64) string {
+	words := []string{}
+	h, t, o := n/100, n/10%10, n%10
+
+	if h == 1 {
+		words = append(words, "sada")
+	} else if h > 1 {
+		words = append(words, onesEE[h]+"sada")
+	}
+
+	switch {
+	case t == 1 && o == 0:
+		words = append(words, "kümme")
+	case t == 1:
+		words = append(words, onesEE[o]+"teist")
+	default:
+		if t > 1 {
+			words = append(words, onesEE[t]+"kümmend")
+		}
+		if o > 0 {
+			words = append(words, onesEE[o])
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+var (
+	onesFI = []string{"nolla", "yksi", "kaksi", "kolme", "neljä", "viisi", "kuusi", "seitsemän", "kahdeksan", "yhdeksän"}
+)
+
+func wordsFI(n int64) string {
+	if n == 0 {
+		return onesFI[0]
+	}
+
+	words := []string{}
+	billions, millions, thousands, rest := split(n)
+	for _, g := range []struct {
+		n        int64
+		one      string
+		multiple string
+	}{
+		{billions, "miljardi", " miljardia"},
+		{millions, "miljoona", " miljoonaa"},
+		{thousands, "tuhat", "tuhatta"},
+	} {
+		switch {
+		case g.n == 1:
+			words = append(words, g.one)
+		case g.n > 1:
+			words = append(words, hundredsFI(g.n)+g.multiple)
+		}
+	}
+
+	if rest > 0 {
+		words = append(words, hundredsFI(rest))
+	}
+
+	return strings.Join(words, " ")
+}
+
+func hundredsFI(n int64) string {
+	s := ""
+	h, t, o := n/100, n/10%10, n%10
+
+	if h == 1 {
+		s = s + "sata"
+	} else if h > 1 {
+		s = s + onesFI[h] + "sataa"
+	}
+
+	switch {
+	case t == 1 && o == 0:
+		s = s + "kymmenen"
+	case t == 1:
+		s = s + onesFI[o] + "toista"
+	default:
+		if t > 1 {
+			s = s + onesFI[t] + "kymmentä"
+		}
+		if o > 0 {
+			s = s + onesFI[o]
+		}
+	}
+
+	return s
+}
+
+var (
+	onesPL     = []string{"zero", "jeden", "dwa", "trzy", "cztery", "pięć", "sześć", "siedem", "osiem", "dziewięć"}
+	teensPL    = []string{"dziesięć", "jedenaście", "dwanaście", "trzynaście", "czternaście", "piętnaście", "szesnaście", "siedemnaście", "osiemnaście", "dziewiętnaście"}
+	tensPL     = []string{"", "", "dwadzieścia", "trzydzieści", "czterdzieści", "pięćdziesiąt", "sześćdziesiąt", "siedemdziesiąt", "osiemdziesiąt", "dziewięćdziesiąt"}
+	hundredsPL = []string{"", "sto", "dwieście", "trzysta", "czterysta", "pięćset", "sześćset", "siedemset", "osiemset", "dziewięćset"}
+)
+
+func wordsPL(n int64) string {
+	if n == 0 {
+		return onesPL[0]
+	}
+
+	words := []string{}
+	billions, millions, thousands, rest := split(n)
+	for _, g := range []struct {
+		n     int64
+		forms [3]string
+	}{
+		{billions, [3]string{"miliard", "miliardy", "miliardów"}},
+		{millions, [3]string{"milion", "miliony", "milionów"}},
+		{thousands, [3]string{"tysiąc", "tysiące", "tysięcy"}},
+	} {
+		switch {
+		case g.n == 1:
+			words = append(words, g.forms[0])
+		case g.n > 1:
+			words = append(words, hundredsPolish(g.n), pluralPL(g.n, g.forms))
+		}
+	}
+
+	if rest > 0 {
+		words = append(words, hundredsPolish(rest))
+	}
+
+	return strings.Join(words, " ")
+}
+
+// pluralPL selects the Polish plural form: 2-4 (except 12-14) use the
+// second form, everything else the genitive plural
+func pluralPL(n int64, forms [3]string) string {
+	o, t := n%10, n%100
+	if o >= 2 && o <= 4 && (t < 12 || t > 14) {
+		return forms[1]
+	}
+	return forms[2]
+}
+
+func hundredsPolish(n int64) string {
+	words := []string{}
+	h, t, o := n/100, n/10%10, n%10
+
+	if h > 0 {
+		words = append(words, hundredsPL[h])
+	}
+
+	switch {
+	case t == 1:
+		words = append(words, teensPL[o])
+	default:
+		if t > 1 {
+			words = append(words, tensPL[t])
+		}
+		if o > 0 {
+			words = append(words, onesPL[o])
+		}
+	}
+
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,72 @@
+package money_test
+
+import (
+	"testing"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+	"github.com/omniboost/go-merit-aktiva/money"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		amount   string
+		currency string
+		locale   string
+		expected string
+	}{
+		{"1234.56", "EUR", money.LocaleEE, "1 234,56 €"},
+		{"-1000000", "EUR", money.LocaleFI, "-1 000 000,00 €"},
+		{"0.5", "PLN", money.LocalePL, "0,50 zł"},
+		{"1234.5", "NOK", "en-US", "1,234.50 NOK"},
+		{"0.005", "EUR", money.LocaleEE, "0,01 €"},
+		{"-0.004", "EUR", money.LocaleEE, "0,00 €"},
+	}
+
+	for _, tt := range tests {
+		s := money.Format(aktiva.MustParseDecimal(tt.amount), tt.currency, tt.locale)
+		if s != tt.expected {
+			t.Errorf("%s %s %s: expected %q, got %q", tt.amount, tt.currency, tt.locale, tt.expected, s)
+		}
+	}
+}
+
+func TestWords(t *testing.T) {
+	tests := []struct {
+		n        int64
+		locale   string
+		expected string
+	}{
+		{0, money.LocaleEE, "null"},
+		{17, money.LocaleEE, "seitseteist"},
+		{123, money.LocaleEE, "sada kakskümmend kolm"},
+		{2500, money.LocaleEE, "kaks tuhat viissada"},
+		{2000000, money.LocaleEE, "kaks miljonit"},
+		{123, money.LocaleFI, "satakaksikymmentäkolme"},
+		{2500, money.LocaleFI, "kaksituhatta viisisataa"},
+		{1010, money.LocaleFI, "tuhat kymmenen"},
+		{123, money.LocalePL, "sto dwadzieścia trzy"},
+		{2500, money.LocalePL, "dwa tysiące pięćset"},
+		{12000, money.LocalePL, "dwanaście tysięcy"},
+		{1000001, money.LocalePL, "milion jeden"},
+	}
+
+	for _, tt := range tests {
+		s, err := money.Words(tt.n, tt.locale)
+		if err != nil {
+			t.Error(err)
+		}
+		if s != tt.expected {
+			t.Errorf("%d %s: expected %q, got %q", tt.n, tt.locale, tt.expected, s)
+		}
+	}
+}
+
+func TestAmountInWords(t *testing.T) {
+	s, err := money.AmountInWords(aktiva.MustParseDecimal("123.45"), "EUR", money.LocaleEE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != "sada kakskümmend kolm EUR 45/100" {
+		t.Errorf("unexpected amount in words: %q", s)
+	}
+}
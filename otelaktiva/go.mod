@@ -0,0 +1,24 @@
+module github.com/omniboost/go-merit-aktiva/otelaktiva
+
+go 1.20
+
+require (
+	github.com/omniboost/go-merit-aktiva v0.0.0
+	go.opentelemetry.io/otel v1.19.0
+	go.opentelemetry.io/otel/sdk v1.19.0
+	go.opentelemetry.io/otel/trace v1.19.0
+)
+
+require (
+	github.com/Azure/go-ntlmssp v0.0.0-20180810175552-4a21cbd618b4 // indirect
+	github.com/go-logr/logr v1.2.4 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/gofrs/uuid v3.2.0+incompatible // indirect
+	github.com/gorilla/schema v0.0.0-20171211162101-9fa3b6af65dc // indirect
+	go.opentelemetry.io/otel/metric v1.19.0 // indirect
+	golang.org/x/crypto v0.0.0-20190122013713-64072686203f // indirect
+	golang.org/x/sys v0.12.0 // indirect
+	gopkg.in/guregu/null.v3 v3.4.0 // indirect
+)
+
+replace github.com/omniboost/go-merit-aktiva => ../
@@ -0,0 +1,131 @@
+// Package otelaktiva adds OpenTelemetry tracing to an aktiva client. It's a
+// separate module so users of the client who don't trace don't get the
+// OpenTelemetry dependencies.
+//
+//	otelaktiva.Instrument(client, otelaktiva.WithTracerProvider(tp))
+//
+// Every call of Do gets a span named after the endpoint with the API ID of the
+// company, the HTTP status and the number of retries. Every attempt, including
+// the retry after a timestamp error or a 429 response, gets a child span.
+package otelaktiva
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/omniboost/go-merit-aktiva/otelaktiva"
+
+// Attribute keys of the spans
+const (
+	EndpointKey   = attribute.Key("merit.endpoint")
+	CompanyIDKey  = attribute.Key("merit.company_id")
+	RequestIDKey  = attribute.Key("merit.request_id")
+	AttemptKey    = attribute.Key("merit.attempt")
+	RetryCountKey = attribute.Key("merit.retry_count")
+	StatusCodeKey = attribute.Key("http.response.status_code")
+)
+
+type config struct {
+	provider trace.TracerProvider
+}
+
+type Option func(*config)
+
+// WithTracerProvider sets the provider of the tracer, the global provider is
+// used otherwise
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.provider = provider
+	}
+}
+
+// Instrument traces the requests of client. It adds middleware and replaces
+// the attempt hook of the client.
+func Instrument(client *aktiva.Client, opts ...Option) {
+	cfg := config{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.provider == nil {
+		cfg.provider = otel.GetTracerProvider()
+	}
+
+	t := tracer{
+		client: client,
+		tracer: cfg.provider.Tracer(instrumentationName),
+	}
+	client.Use(t.middleware)
+	client.SetAttemptHook(t.attempt)
+}
+
+type tracer struct {
+	client *aktiva.Client
+	tracer trace.Tracer
+}
+
+// attemptsKey holds the number of attempts of the request in the context
+type attemptsKey struct{}
+
+func (t tracer) middleware(next aktiva.HandlerFunc) aktiva.HandlerFunc {
+	return func(req *http.Request, responseBody interface{}) (*http.Response, error) {
+		endpoint := t.client.Endpoint(*req.URL)
+		ctx, span := t.tracer.Start(req.Context(), "merit "+endpoint,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				EndpointKey.String(endpoint),
+				CompanyIDKey.String(t.client.APIID()),
+				RequestIDKey.String(aktiva.RequestID(req)),
+			),
+		)
+		defer span.End()
+
+		attempts := new(int64)
+		ctx = context.WithValue(ctx, attemptsKey{}, attempts)
+
+		resp, err := next(req.WithContext(ctx), responseBody)
+
+		if n := atomic.LoadInt64(attempts); n > 0 {
+			span.SetAttributes(RetryCountKey.Int64(n - 1))
+		}
+		end(span, resp, err)
+		return resp, err
+	}
+}
+
+func (t tracer) attempt(req *http.Request, attempt int) (*http.Request, func(*http.Response, error)) {
+	if attempts, ok := req.Context().Value(attemptsKey{}).(*int64); ok {
+		atomic.StoreInt64(attempts, int64(attempt))
+	}
+
+	endpoint := t.client.Endpoint(*req.URL)
+	ctx, span := t.tracer.Start(req.Context(), "merit "+endpoint+" attempt",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			EndpointKey.String(endpoint),
+			AttemptKey.Int(attempt),
+		),
+	)
+
+	return req.WithContext(ctx), func(resp *http.Response, err error) {
+		end(span, resp, err)
+		span.End()
+	}
+}
+
+func end(span trace.Span, resp *http.Response, err error) {
+	if resp != nil {
+		span.SetAttributes(StatusCodeKey.Int(resp.StatusCode))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
@@ -0,0 +1,73 @@
+package otelaktiva_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+	"github.com/omniboost/go-merit-aktiva/otelaktiva"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestInstrument(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"Message": "Invalid timestamp"}`))
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "company-1", "key")
+	c.SetBaseURL(*baseURL)
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otelaktiva.Instrument(c, otelaktiva.WithTracerProvider(provider))
+
+	req := c.NewGetTaxesRequest()
+	_, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("expected 2 attempt spans and a request span, got %d", len(spans))
+	}
+
+	request := spans[2]
+	if request.Name() != "merit gettaxes" {
+		t.Errorf("unexpected span name %s", request.Name())
+	}
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range request.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	if attrs[otelaktiva.CompanyIDKey].AsString() != "company-1" {
+		t.Errorf("unexpected company id %v", attrs[otelaktiva.CompanyIDKey])
+	}
+	if attrs[otelaktiva.RetryCountKey].AsInt64() != 1 {
+		t.Errorf("expected 1 retry, got %v", attrs[otelaktiva.RetryCountKey])
+	}
+	if attrs[otelaktiva.StatusCodeKey].AsInt64() != http.StatusOK {
+		t.Errorf("unexpected status %v", attrs[otelaktiva.StatusCodeKey])
+	}
+
+	for i, span := range spans[:2] {
+		if span.Parent().SpanID() != request.SpanContext().SpanID() {
+			t.Errorf("attempt %d isn't a child of the request span", i+1)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// Package pool manages the clients of many Merit Aktiva companies, e.g. for
+// an agency syncing the books of all its customers from one worker. All
+// clients share the connection pool of a base client, and every company gets
+// its own rate limiter since Merit's quota is per API key.
+package pool
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+// Limits is the request quota of one company
+type Limits struct {
+	Requests int
+	Period   time.Duration
+}
+
+// DefaultLimits is the quota used when the pool is created with zero limits
+var DefaultLimits = Limits{Requests: 60, Period: time.Minute}
+
+// Stats is the rate limit state of a company
+type Stats struct {
+	// Requests that got a response
+	Requests int64
+	// Requests throttled by Merit (HTTP 429)
+	Throttled int64
+	// Merit asked not to send requests before this time
+	ThrottledUntil time.Time
+}
+
+// CompanyPool hands out a client per company ID
+type CompanyPool struct {
+	base   *aktiva.Client
+	limits Limits
+
+	mu        sync.Mutex
+	companies map[string]*company
+}
+
+type company struct {
+	client *aktiva.Client
+
+	mu    sync.Mutex
+	stats Stats
+}
+
+// New returns a pool cloning base for every company. Zero limits use
+// DefaultLimits.
+func New(base *aktiva.Client, limits Limits) *CompanyPool {
+	if limits.Requests == 0 || limits.Period == 0 {
+		limits = DefaultLimits
+	}
+
+	return &CompanyPool{
+		base:      base,
+		limits:    limits,
+		companies: map[string]*company{},
+	}
+}
+
+// Add registers the credentials of a company. Adding a company ID again
+// replaces its client and resets its stats. A request completion callback of
+// the base client keeps being called.
+func (p *CompanyPool) Add(companyID, apiID, apiKey string) *aktiva.Client {
+	c := &company{}
+	c.client = p.base.WithCredentials(apiID, apiKey)
+	c.client.SetRateLimiter(aktiva.NewRateLimiter(p.limits.Requests, p.limits.Period))
+
+	next := c.client.OnRequestCompleted()
+	c.client.SetOnRequestCompleted(func(req *http.Request, resp *http.Response) {
+		c.track(req, resp)
+		if next != nil {
+			next(req, resp)
+		}
+	})
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.companies[companyID] = c
+	return c.client
+}
+
+// Remove unregisters a company
+func (p *CompanyPool) Remove(companyID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.companies, companyID)
+}
+
+// Client returns the client of a company
+func (p *CompanyPool) Client(companyID string) (*aktiva.Client, error) {
+	c, err := p.company(companyID)
+	if err != nil {
+		return nil, err
+	}
+	return c.client, nil
+}
+
+// Companies returns the IDs of all registered companies
+func (p *CompanyPool) Companies() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ids := make([]string, 0, len(p.companies))
+	for id := range p.companies {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Stats returns the rate limit state of a company
+func (p *CompanyPool) Stats(companyID string) (Stats, error) {
+	c, err := p.company(companyID)
+	if err != nil {
+		return Stats{}, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats, nil
+}
+
+func (p *CompanyPool) company(companyID string) (*company, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	c, ok := p.companies[companyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown company \"%s\"", companyID)
+	}
+	return c, nil
+}
+
+func (c *company) track(req *http.Request, resp *http.Response) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stats.Requests++
+	if resp.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+
+	c.stats.Throttled++
+	c.stats.ThrottledUntil = time.Now().Add(aktiva.RetryAfter(resp))
+}
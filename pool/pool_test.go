@@ -0,0 +1,67 @@
+package pool_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+	"github.com/omniboost/go-merit-aktiva/pool"
+)
+
+func TestCompanyPool(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("ApiId") == "b" {
+			w.Header().Set("Retry-After", time.Now().Add(2*time.Minute).UTC().Format(http.TimeFormat))
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"Message": "Too many requests"}`))
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	base := aktiva.NewClient(nil, "", "")
+	base.SetBaseURL(*baseURL)
+	completed := 0
+	base.SetOnRequestCompleted(func(*http.Request, *http.Response) {
+		completed++
+	})
+
+	p := pool.New(base, pool.Limits{})
+	p.Add("company-a", "a", "key-a")
+	p.Add("company-b", "b", "key-b")
+
+	for _, id := range []string{"company-a", "company-b"} {
+		c, err := p.Client(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req := c.NewGetTaxesRequest()
+		req.Do(context.Background())
+	}
+
+	a, _ := p.Stats("company-a")
+	if a.Requests != 1 || a.Throttled != 0 {
+		t.Errorf("unexpected stats for company-a: %+v", a)
+	}
+
+	b, _ := p.Stats("company-b")
+	if b.Throttled != 1 || b.ThrottledUntil.Before(time.Now().Add(time.Minute)) {
+		t.Errorf("unexpected stats for company-b: %+v", b)
+	}
+
+	if completed != 2 {
+		t.Errorf("expected the callback of the base client to be called twice, got %d", completed)
+	}
+
+	_, err := p.Client("company-c")
+	if err == nil {
+		t.Error("expected an error for an unknown company")
+	}
+}
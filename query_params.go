@@ -0,0 +1,45 @@
+package aktiva
+
+import (
+	"net/url"
+
+	"github.com/gorilla/schema"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+// QueryParams is implemented by the query parameters of every request, the
+// counterpart of PathParams
+type QueryParams interface {
+	ToURLValues() (url.Values, error)
+}
+
+// NewSchemaEncoder returns the encoder for query parameter structs. On top of
+// the encoders of utils.NewSchemaEncoder it formats Date and DateTime fields
+// the way Merit expects them (yyyyMMdd and yyyyMMddHHmmss). Booleans are
+// encoded as true/false. Zero dates are encoded as an empty value; don't use
+// omitempty on date fields, the schema package can't check them for zero.
+func NewSchemaEncoder() *schema.Encoder {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Timestamp{}, utils.EncodeSchemaMarshaler)
+	return encoder
+}
+
+// MarshalSchema returns the date as a query parameter value, empty for the
+// zero date
+func (d Date) MarshalSchema() string {
+	if d.IsEmpty() {
+		return ""
+	}
+	return d.String()
+}
+
+// MarshalSchema returns the date time as a query parameter value, empty for
+// the zero time
+func (d DateTime) MarshalSchema() string {
+	if d.IsEmpty() {
+		return ""
+	}
+	return d.String()
+}
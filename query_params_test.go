@@ -0,0 +1,33 @@
+package aktiva_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestNewSchemaEncoder(t *testing.T) {
+	params := struct {
+		PeriodStart aktiva.Date     `schema:"PeriodStart"`
+		Changed     aktiva.DateTime `schema:"Changed"`
+		Empty       aktiva.Date     `schema:"Empty"`
+		Locked      bool            `schema:"Locked"`
+	}{
+		PeriodStart: aktiva.Date{Time: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)},
+		Changed:     aktiva.DateTime{Time: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)},
+		Locked:      true,
+	}
+
+	values := url.Values{}
+	err := aktiva.NewSchemaEncoder().Encode(params, values)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "Changed=20200102030405&Empty=&Locked=true&PeriodStart=20200102"
+	if values.Encode() != expected {
+		t.Errorf("expected %s, got %s", expected, values.Encode())
+	}
+}
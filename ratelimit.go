@@ -0,0 +1,207 @@
+package aktiva
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimiter blocks until a request may be sent. *rate.Limiter of
+// golang.org/x/time/rate satisfies it.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// SetRateLimiter throttles all requests of the client, including retries. Use
+// NewRateLimiter for the per-minute quota of an API key, nil disables it.
+func (c *Client) SetRateLimiter(limiter RateLimiter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rateLimiter = limiter
+}
+
+func (c *Client) RateLimiter() RateLimiter {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.rateLimiter
+}
+
+// NewRateLimiter returns a limiter allowing n requests per period. Up to n
+// requests are sent right away, after that they're spread evenly.
+func NewRateLimiter(n int, period time.Duration) RateLimiter {
+	if n < 1 {
+		n = 1
+	}
+
+	return &tokenBucket{
+		interval: period / time.Duration(n),
+		burst:    n,
+		now:      time.Now,
+	}
+}
+
+type tokenBucket struct {
+	mu       sync.Mutex
+	interval time.Duration
+	burst    int
+	// time the next request may be sent when the bucket is empty
+	next time.Time
+	now  func() time.Time
+}
+
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	slot, delay := b.reserve()
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		b.release(slot)
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve returns the reserved slot and how long to wait until it
+func (b *tokenBucket) reserve() (time.Time, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	slot := now.Add(-time.Duration(b.burst-1) * b.interval)
+	if b.next.After(slot) {
+		slot = b.next
+	}
+
+	b.next = slot.Add(b.interval)
+	return slot, slot.Sub(now)
+}
+
+// release gives back the slot of a caller that stopped waiting. Only the last
+// reservation can be given back: the callers after it keep their slots.
+func (b *tokenBucket) release(slot time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.next.Equal(slot.Add(b.interval)) {
+		b.next = slot
+	}
+}
+
+// defaultRetryAfter is used when a throttled response has no Retry-After
+// header
+const defaultRetryAfter = time.Second
+
+// RateLimitError is returned when Merit throttled the request (HTTP 429)
+type RateLimitError struct {
+	// HTTP response that caused this error
+	Response *http.Response
+	// How long to wait before sending a new request
+	RetryAfter time.Duration
+	// Requests left in the current window, -1 when not reported
+	Remaining int
+	// Requests allowed in the window, -1 when not reported
+	Limit int
+	Err   error
+}
+
+func newRateLimitError(r *http.Response, err error) *RateLimitError {
+	return &RateLimitError{
+		Response:   r,
+		RetryAfter: parseRetryAfter(r.Header.Get("Retry-After"), time.Now()),
+		Remaining:  headerInt(r.Header, "X-RateLimit-Remaining"),
+		Limit:      headerInt(r.Header, "X-RateLimit-Limit"),
+		Err:        err,
+	}
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limited, retry after %s: %s", e.RetryAfter, e.Err)
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return e.Err
+}
+
+// RetryAfter returns how long Merit asked to wait before the next request in
+// the Retry-After header of resp, in seconds or as an HTTP date. It's one
+// second when the header is missing or invalid.
+func RetryAfter(resp *http.Response) time.Duration {
+	return parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+}
+
+// parseRetryAfter parses the seconds or HTTP date of a Retry-After header
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return defaultRetryAfter
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return defaultRetryAfter
+	}
+
+	if date.Before(now) {
+		return 0
+	}
+	return date.Sub(now)
+}
+
+func headerInt(h http.Header, key string) int {
+	i, err := strconv.Atoi(h.Get(key))
+	if err != nil {
+		return -1
+	}
+	return i
+}
+
+// SetMaxRetryAfter sets the longest Retry-After a throttled request is retried
+// after, once. Zero disables retrying throttled requests.
+func (c *Client) SetMaxRetryAfter(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.maxRetryAfter = d
+}
+
+func (c *Client) MaxRetryAfter() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.maxRetryAfter
+}
+
+// waitRetryAfter sleeps for the delay of a RateLimitError, false when err isn't
+// one or its delay is too long
+func (c *Client) waitRetryAfter(ctx context.Context, err error) bool {
+	maxRetryAfter := c.MaxRetryAfter()
+	rateErr := &RateLimitError{}
+	if !errors.As(err, &rateErr) || rateErr.RetryAfter > maxRetryAfter || maxRetryAfter == 0 {
+		return false
+	}
+
+	timer := time.NewTimer(rateErr.RetryAfter)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,144 @@
+package aktiva_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+type countingLimiter struct {
+	calls int
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.calls++
+	return nil
+}
+
+func TestSetRateLimiter(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	limiter := &countingLimiter{}
+	c.SetRateLimiter(limiter)
+
+	req := c.NewGetTaxesRequest()
+	for i := 0; i < 3; i++ {
+		_, err := req.Do(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if limiter.calls != 3 {
+		t.Errorf("expected 3 waits, got %d", limiter.calls)
+	}
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	limiter := aktiva.NewRateLimiter(2, 200*time.Millisecond)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		err := limiter.Wait(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if time.Since(start) > 50*time.Millisecond {
+		t.Error("expected the burst to pass without waiting")
+	}
+
+	// the third request waits for the next slot
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	err := limiter.Wait(ctx)
+	if err != context.DeadlineExceeded {
+		t.Errorf("expected the limiter to wait, got %v", err)
+	}
+}
+
+func TestRateLimiterReleasesCancelledWaits(t *testing.T) {
+	limiter := aktiva.NewRateLimiter(1, 100*time.Millisecond)
+
+	start := time.Now()
+	err := limiter.Wait(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// callers giving up don't push back the next slot
+	for i := 0; i < 5; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		err := limiter.Wait(ctx)
+		cancel()
+		if err != context.DeadlineExceeded {
+			t.Fatalf("expected the limiter to wait, got %v", err)
+		}
+	}
+
+	err = limiter.Wait(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > 300*time.Millisecond {
+		t.Errorf("expected the next slot after 100ms, waited %s", elapsed)
+	}
+}
+
+func TestRateLimitRetry(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"Message": "Too many requests"}`))
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	req := c.NewGetTaxesRequest()
+	_, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+
+	// without retries the error is returned
+	calls = 0
+	c.SetMaxRetryAfter(0)
+	_, err = req.Do(context.Background())
+
+	rateErr := &aktiva.RateLimitError{}
+	if !errors.As(err, &rateErr) {
+		t.Fatalf("expected a RateLimitError, got %v", err)
+	}
+	if rateErr.RetryAfter != 0 || rateErr.Remaining != 0 || rateErr.Limit != -1 {
+		t.Errorf("unexpected rate limit %+v", rateErr)
+	}
+}
@@ -0,0 +1,15 @@
+package aktiva
+
+import "regexp"
+
+var (
+	redactQueryRegexp  = regexp.MustCompile(`(?i)\b(ApiId|signature)=[^&\s"]*`)
+	redactHeaderRegexp = regexp.MustCompile(`(?im)^((?:Proxy-)?Authorization|Cookie|Set-Cookie):.*$`)
+)
+
+// redactDump hides the credentials and signatures in a request or response
+// dump so debug logs can be shared
+func redactDump(dump []byte) []byte {
+	dump = redactQueryRegexp.ReplaceAll(dump, []byte("$1=REDACTED"))
+	return redactHeaderRegexp.ReplaceAll(dump, []byte("$1: REDACTED"))
+}
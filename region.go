@@ -0,0 +1,69 @@
+package aktiva
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Region is the edition of Merit Aktiva, each runs on its own host
+type Region string
+
+const (
+	// Estonia, Merit Aktiva
+	RegionEE Region = "EE"
+	// Finland, Passeli Merit
+	RegionFI Region = "FI"
+	// Poland, Merit Aktiva PL (360 Księgowość)
+	RegionPL Region = "PL"
+)
+
+var (
+	BaseURLEE = BaseURL
+	BaseURLFI = url.URL{
+		Scheme: "https",
+		Host:   "aktiva.meritaktiva.fi",
+		Path:   "/api/v1/",
+	}
+	BaseURLPL = url.URL{
+		Scheme: "https",
+		Host:   "program.360ksiegowosc.pl",
+		Path:   "/api/v1/",
+	}
+)
+
+var regions = map[Region]struct {
+	baseURL  url.URL
+	location string
+}{
+	RegionEE: {BaseURLEE, "Europe/Tallinn"},
+	RegionFI: {BaseURLFI, "Europe/Helsinki"},
+	RegionPL: {BaseURLPL, "Europe/Warsaw"},
+}
+
+// BaseURL returns the API base URL of the region, false for unknown regions
+func (r Region) BaseURL() (url.URL, bool) {
+	region, ok := regions[r]
+	return region.baseURL, ok
+}
+
+// NewClientForRegion returns a client for the region's host with the dates
+// and timestamps in the region's time zone. The location is left at its
+// default when the time zone database isn't available. Unknown regions get
+// the Estonian defaults of NewClient.
+func NewClientForRegion(httpClient *http.Client, region Region, apiID, apiKey string) *Client {
+	client := NewClient(httpClient, apiID, apiKey)
+
+	r, ok := regions[region]
+	if !ok {
+		return client
+	}
+
+	client.SetBaseURL(r.baseURL)
+	location, err := time.LoadLocation(r.location)
+	if err == nil {
+		client.SetLocation(location)
+	}
+
+	return client
+}
@@ -0,0 +1,36 @@
+package aktiva
+
+import (
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// RequestIDHeader carries the ID of a request. It's generated by NewRequest
+// unless set with WithRequestID, and stays the same when the request is
+// retried.
+const RequestIDHeader = "X-Request-Id"
+
+var requestIDCounter int64
+
+func newRequestID() string {
+	id, err := uuid.NewV4()
+	if err == nil {
+		return id.String()
+	}
+
+	// no randomness available: still unique within the process
+	n := atomic.AddInt64(&requestIDCounter, 1)
+	return strconv.FormatInt(time.Now().UnixNano(), 36) + "-" + strconv.FormatInt(n, 36)
+}
+
+// RequestID returns the ID of a request created by NewRequest
+func RequestID(req *http.Request) string {
+	if req == nil {
+		return ""
+	}
+	return req.Header.Get(RequestIDHeader)
+}
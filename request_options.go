@@ -0,0 +1,84 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// RequestOption changes a single call of a request's Do, without changing the
+// client's settings for other calls
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	timeout               time.Duration
+	headers               http.Header
+	disallowUnknownFields *bool
+	debug                 *bool
+	requestID             string
+}
+
+// WithTimeout limits the duration of the call, including retries
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithHeader adds a header to the HTTP request
+func WithHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		o.headers.Add(key, value)
+	}
+}
+
+// WithDisallowUnknownFields overrides the client's SetDisallowUnknownFields
+func WithDisallowUnknownFields(disallowUnknownFields bool) RequestOption {
+	return func(o *requestOptions) {
+		o.disallowUnknownFields = &disallowUnknownFields
+	}
+}
+
+// WithDebug overrides the client's SetDebug
+func WithDebug(debug bool) RequestOption {
+	return func(o *requestOptions) {
+		o.debug = &debug
+	}
+}
+
+// WithRequestID sends id as the request ID instead of a generated one
+func WithRequestID(id string) RequestOption {
+	return func(o *requestOptions) {
+		o.requestID = id
+	}
+}
+
+type requestOptionsKey struct{}
+
+// ApplyRequestOptions returns a context carrying the options, which
+// NewRequest and Do pick up. The cancel function must be called when the call
+// is done. Requests call it at the start of Do.
+func ApplyRequestOptions(ctx context.Context, opts []RequestOption) (context.Context, context.CancelFunc) {
+	if ctx == nil || len(opts) == 0 {
+		return ctx, func() {}
+	}
+
+	o := &requestOptions{headers: http.Header{}}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	ctx = context.WithValue(ctx, requestOptionsKey{}, o)
+	if o.timeout > 0 {
+		return context.WithTimeout(ctx, o.timeout)
+	}
+	return ctx, func() {}
+}
+
+func optionsFromContext(ctx context.Context) *requestOptions {
+	o, ok := ctx.Value(requestOptionsKey{}).(*requestOptions)
+	if !ok {
+		return &requestOptions{}
+	}
+	return o
+}
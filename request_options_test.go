@@ -0,0 +1,70 @@
+package aktiva_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestRequestOptions(t *testing.T) {
+	var header string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("X-Correlation-Id")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"Code": "VAT20", "Unknown": true}]`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	req := c.NewGetTaxesRequest()
+	_, err := req.Do(context.Background(), aktiva.WithHeader("X-Correlation-Id", "abc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header != "abc" {
+		t.Errorf("expected the extra header, got %q", header)
+	}
+
+	_, err = req.Do(context.Background(), aktiva.WithDisallowUnknownFields(true))
+	decodeErr := &aktiva.DecodeError{}
+	if !errors.As(err, &decodeErr) {
+		t.Errorf("expected a strict decoding error, got %v", err)
+	}
+
+	// the options don't change the client
+	_, err = req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header != "" {
+		t.Errorf("expected no extra header, got %q", header)
+	}
+}
+
+func TestRequestOptionTimeout(t *testing.T) {
+	done := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer ts.Close()
+	defer close(done)
+
+	baseURL, _ := url.Parse(ts.URL + "/api/v1/")
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetBaseURL(*baseURL)
+
+	req := c.NewGetTaxesRequest()
+	_, err := req.Do(context.Background(), aktiva.WithTimeout(20*time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
@@ -0,0 +1,80 @@
+package aktiva
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// Environment variables read by SandboxConfigFromEnv
+const (
+	EnvSandboxAPIID   = "MERIT_SANDBOX_API_ID"
+	EnvSandboxAPIKey  = "MERIT_SANDBOX_API_KEY"
+	EnvSandboxBaseURL = "MERIT_SANDBOX_BASE_URL"
+	EnvSandboxRegion  = "MERIT_SANDBOX_REGION"
+	EnvSandboxDebug   = "MERIT_SANDBOX_DEBUG"
+)
+
+// ErrNoSandbox is returned by NewSandboxClient when no sandbox credentials
+// are configured. Integration tests can skip on it.
+var ErrNoSandbox = errors.New("no sandbox credentials configured")
+
+// SandboxConfig points at a Merit demo or test company. Merit has no separate
+// sandbox host: a demo company is an ordinary company with its own API
+// credentials, on the regular host unless BaseURL is set (e.g. a fake server).
+type SandboxConfig struct {
+	APIID   string
+	APIKey  string
+	Region  Region
+	BaseURL *url.URL
+	Debug   bool
+}
+
+// SandboxConfigFromEnv reads the MERIT_SANDBOX_* environment variables. It
+// deliberately doesn't fall back to the credentials used for the live
+// company.
+func SandboxConfigFromEnv() (SandboxConfig, error) {
+	config := SandboxConfig{
+		APIID:  os.Getenv(EnvSandboxAPIID),
+		APIKey: os.Getenv(EnvSandboxAPIKey),
+		Region: Region(strings.ToUpper(os.Getenv(EnvSandboxRegion))),
+		Debug:  os.Getenv(EnvSandboxDebug) != "",
+	}
+
+	if s := os.Getenv(EnvSandboxBaseURL); s != "" {
+		baseURL, err := url.Parse(s)
+		if err != nil {
+			return config, err
+		}
+		config.BaseURL = baseURL
+	}
+
+	return config, nil
+}
+
+// NewSandboxClient returns a client for the demo company configured in the
+// environment, ErrNoSandbox when the credentials aren't set
+func NewSandboxClient(httpClient *http.Client) (*Client, error) {
+	config, err := SandboxConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return config.NewClient(httpClient)
+}
+
+// NewClient returns a client for the sandbox company, ErrNoSandbox when the
+// credentials are empty
+func (c SandboxConfig) NewClient(httpClient *http.Client) (*Client, error) {
+	if c.APIID == "" || c.APIKey == "" {
+		return nil, ErrNoSandbox
+	}
+
+	client := NewClientForRegion(httpClient, c.Region, c.APIID, c.APIKey)
+	if c.BaseURL != nil {
+		client.SetBaseURL(*c.BaseURL)
+	}
+	client.SetDebug(c.Debug)
+	return client, nil
+}
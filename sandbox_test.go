@@ -0,0 +1,34 @@
+package aktiva_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestNewSandboxClient(t *testing.T) {
+	for _, k := range []string{aktiva.EnvSandboxAPIID, aktiva.EnvSandboxAPIKey, aktiva.EnvSandboxBaseURL, aktiva.EnvSandboxRegion} {
+		defer os.Setenv(k, os.Getenv(k))
+		os.Unsetenv(k)
+	}
+
+	_, err := aktiva.NewSandboxClient(nil)
+	if !errors.Is(err, aktiva.ErrNoSandbox) {
+		t.Errorf("expected ErrNoSandbox, got %v", err)
+	}
+
+	os.Setenv(aktiva.EnvSandboxAPIID, "demo-id")
+	os.Setenv(aktiva.EnvSandboxAPIKey, "demo-key")
+	os.Setenv(aktiva.EnvSandboxRegion, "fi")
+	c, err := aktiva.NewSandboxClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	baseURL := c.BaseURL()
+	if c.APIID() != "demo-id" || baseURL.Host != aktiva.BaseURLFI.Host {
+		t.Errorf("unexpected client for %s at %s", c.APIID(), baseURL.Host)
+	}
+}
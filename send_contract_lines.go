@@ -0,0 +1,154 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewSendContractLinesRequest() SendContractLinesRequest {
+	r := SendContractLinesRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewSendContractLinesQueryParams()
+	r.pathParams = r.NewSendContractLinesPathParams()
+	r.requestBody = r.NewSendContractLinesRequestBody()
+	return r
+}
+
+type SendContractLinesRequest struct {
+	client      *Client
+	queryParams *SendContractLinesQueryParams
+	pathParams  *SendContractLinesPathParams
+	method      string
+	headers     http.Header
+	requestBody SendContractLinesRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r SendContractLinesRequest) Clone() SendContractLinesRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r SendContractLinesRequest) NewSendContractLinesQueryParams() *SendContractLinesQueryParams {
+	return &SendContractLinesQueryParams{}
+}
+
+type SendContractLinesQueryParams struct {
+}
+
+func (p SendContractLinesQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SendContractLinesRequest) QueryParams() *SendContractLinesQueryParams {
+	return r.queryParams
+}
+
+func (r SendContractLinesRequest) NewSendContractLinesPathParams() *SendContractLinesPathParams {
+	return &SendContractLinesPathParams{}
+}
+
+type SendContractLinesPathParams struct {
+}
+
+func (p *SendContractLinesPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *SendContractLinesRequest) PathParams() *SendContractLinesPathParams {
+	return r.pathParams
+}
+
+func (r *SendContractLinesRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SendContractLinesRequest) Method() string {
+	return r.method
+}
+
+func (r SendContractLinesRequest) NewSendContractLinesRequestBody() SendContractLinesRequestBody {
+	return SendContractLinesRequestBody{}
+}
+
+type SendContractLinesRequestBody struct {
+	// Required
+	ContractID uuid.UUID     `json:"ContractId"`
+	Lines      ContractLines `json:"Lines"`
+}
+
+func (r *SendContractLinesRequest) RequestBody() *SendContractLinesRequestBody {
+	return &r.requestBody
+}
+
+func (r *SendContractLinesRequest) SetRequestBody(body SendContractLinesRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SendContractLinesRequest) NewResponseBody() *SendContractLinesResponseBody {
+	return &SendContractLinesResponseBody{}
+}
+
+type SendContractLinesResponseBody struct {
+	ContractID uuid.UUID `json:"ContractId"`
+}
+
+func (r *SendContractLinesRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("sendcontractlines", r.PathParams())
+}
+
+func (r *SendContractLinesRequest) Do(ctx context.Context, opts ...RequestOption) (SendContractLinesResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,38 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestSendContractLines(t *testing.T) {
+	b := []byte(`
+		{
+			"ContractId": "8f0e6b6e-5b07-4d7e-b1fb-3b0cfb0c9d1a",
+			"Lines": [
+				{
+					"Item": {"Code": "RENT", "Description": "Office rent", "Type": 2},
+					"Quantity": 1,
+					"Price": 750,
+					"TaxId": "b9b25735-6a15-4d4e-8720-25b254ae3d21"
+				}
+			]
+		}
+	`)
+
+	req := client.NewSendContractLinesRequest()
+	err := json.Unmarshal(b, req.RequestBody())
+	if err != nil {
+		t.Error(err)
+	}
+
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ = json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
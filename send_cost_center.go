@@ -0,0 +1,157 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewSendCostCenterRequest() SendCostCenterRequest {
+	r := SendCostCenterRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewSendCostCenterQueryParams()
+	r.pathParams = r.NewSendCostCenterPathParams()
+	r.requestBody = r.NewSendCostCenterRequestBody()
+	return r
+}
+
+type SendCostCenterRequest struct {
+	client      *Client
+	queryParams *SendCostCenterQueryParams
+	pathParams  *SendCostCenterPathParams
+	method      string
+	headers     http.Header
+	requestBody SendCostCenterRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r SendCostCenterRequest) Clone() SendCostCenterRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r SendCostCenterRequest) NewSendCostCenterQueryParams() *SendCostCenterQueryParams {
+	return &SendCostCenterQueryParams{}
+}
+
+type SendCostCenterQueryParams struct {
+}
+
+func (p SendCostCenterQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SendCostCenterRequest) QueryParams() *SendCostCenterQueryParams {
+	return r.queryParams
+}
+
+func (r SendCostCenterRequest) NewSendCostCenterPathParams() *SendCostCenterPathParams {
+	return &SendCostCenterPathParams{}
+}
+
+type SendCostCenterPathParams struct {
+}
+
+func (p *SendCostCenterPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *SendCostCenterRequest) PathParams() *SendCostCenterPathParams {
+	return r.pathParams
+}
+
+func (r *SendCostCenterRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SendCostCenterRequest) Method() string {
+	return r.method
+}
+
+func (r SendCostCenterRequest) NewSendCostCenterRequestBody() SendCostCenterRequestBody {
+	return SendCostCenterRequestBody{}
+}
+
+type SendCostCenterRequestBody NewCostCenter
+
+func (r *SendCostCenterRequest) RequestBody() *SendCostCenterRequestBody {
+	return &r.requestBody
+}
+
+func (r *SendCostCenterRequest) SetRequestBody(body SendCostCenterRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SendCostCenterRequest) NewResponseBody() *SendCostCenterResponseBody {
+	return &SendCostCenterResponseBody{}
+}
+
+type SendCostCenterResponseBody struct {
+	ID uuid.UUID `json:"Id"`
+}
+
+func (r *SendCostCenterRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("sendcostcenter", r.PathParams())
+}
+
+func (r *SendCostCenterRequest) Do(ctx context.Context, opts ...RequestOption) (SendCostCenterResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type NewCostCenter struct {
+	// Required
+	Code string `json:"Code"`
+	// Required
+	Name string `json:"Name"`
+}
@@ -0,0 +1,31 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestSendCostCenter(t *testing.T) {
+	b := []byte(`
+		{
+			"Code": "MKT",
+			"Name": "Marketing"
+		}
+	`)
+
+	req := client.NewSendCostCenterRequest()
+	err := json.Unmarshal(b, req.RequestBody())
+	if err != nil {
+		t.Error(err)
+	}
+
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ = json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
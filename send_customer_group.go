@@ -0,0 +1,157 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewSendCustomerGroupRequest() SendCustomerGroupRequest {
+	r := SendCustomerGroupRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewSendCustomerGroupQueryParams()
+	r.pathParams = r.NewSendCustomerGroupPathParams()
+	r.requestBody = r.NewSendCustomerGroupRequestBody()
+	return r
+}
+
+type SendCustomerGroupRequest struct {
+	client      *Client
+	queryParams *SendCustomerGroupQueryParams
+	pathParams  *SendCustomerGroupPathParams
+	method      string
+	headers     http.Header
+	requestBody SendCustomerGroupRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r SendCustomerGroupRequest) Clone() SendCustomerGroupRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r SendCustomerGroupRequest) NewSendCustomerGroupQueryParams() *SendCustomerGroupQueryParams {
+	return &SendCustomerGroupQueryParams{}
+}
+
+type SendCustomerGroupQueryParams struct {
+}
+
+func (p SendCustomerGroupQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SendCustomerGroupRequest) QueryParams() *SendCustomerGroupQueryParams {
+	return r.queryParams
+}
+
+func (r SendCustomerGroupRequest) NewSendCustomerGroupPathParams() *SendCustomerGroupPathParams {
+	return &SendCustomerGroupPathParams{}
+}
+
+type SendCustomerGroupPathParams struct {
+}
+
+func (p *SendCustomerGroupPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *SendCustomerGroupRequest) PathParams() *SendCustomerGroupPathParams {
+	return r.pathParams
+}
+
+func (r *SendCustomerGroupRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SendCustomerGroupRequest) Method() string {
+	return r.method
+}
+
+func (r SendCustomerGroupRequest) NewSendCustomerGroupRequestBody() SendCustomerGroupRequestBody {
+	return SendCustomerGroupRequestBody{}
+}
+
+type SendCustomerGroupRequestBody NewCustomerGroup
+
+func (r *SendCustomerGroupRequest) RequestBody() *SendCustomerGroupRequestBody {
+	return &r.requestBody
+}
+
+func (r *SendCustomerGroupRequest) SetRequestBody(body SendCustomerGroupRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SendCustomerGroupRequest) NewResponseBody() *SendCustomerGroupResponseBody {
+	return &SendCustomerGroupResponseBody{}
+}
+
+type SendCustomerGroupResponseBody struct {
+	ID uuid.UUID `json:"Id"`
+}
+
+func (r *SendCustomerGroupRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("sendcustomergroup", r.PathParams())
+}
+
+func (r *SendCustomerGroupRequest) Do(ctx context.Context, opts ...RequestOption) (SendCustomerGroupResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type NewCustomerGroup struct {
+	// Required
+	Code string `json:"Code"`
+	// Required
+	Name string `json:"Name"`
+}
@@ -0,0 +1,31 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestSendCustomerGroup(t *testing.T) {
+	b := []byte(`
+		{
+			"Code": "WEB",
+			"Name": "Web shop customers"
+		}
+	`)
+
+	req := client.NewSendCustomerGroupRequest()
+	err := json.Unmarshal(b, req.RequestBody())
+	if err != nil {
+		t.Error(err)
+	}
+
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ = json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
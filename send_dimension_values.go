@@ -0,0 +1,165 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewSendDimensionValuesRequest() SendDimensionValuesRequest {
+	r := SendDimensionValuesRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewSendDimensionValuesQueryParams()
+	r.pathParams = r.NewSendDimensionValuesPathParams()
+	r.requestBody = r.NewSendDimensionValuesRequestBody()
+	return r
+}
+
+type SendDimensionValuesRequest struct {
+	client      *Client
+	queryParams *SendDimensionValuesQueryParams
+	pathParams  *SendDimensionValuesPathParams
+	method      string
+	headers     http.Header
+	requestBody SendDimensionValuesRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r SendDimensionValuesRequest) Clone() SendDimensionValuesRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r SendDimensionValuesRequest) NewSendDimensionValuesQueryParams() *SendDimensionValuesQueryParams {
+	return &SendDimensionValuesQueryParams{}
+}
+
+type SendDimensionValuesQueryParams struct {
+}
+
+func (p SendDimensionValuesQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SendDimensionValuesRequest) QueryParams() *SendDimensionValuesQueryParams {
+	return r.queryParams
+}
+
+func (r SendDimensionValuesRequest) NewSendDimensionValuesPathParams() *SendDimensionValuesPathParams {
+	return &SendDimensionValuesPathParams{}
+}
+
+type SendDimensionValuesPathParams struct {
+}
+
+func (p *SendDimensionValuesPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *SendDimensionValuesRequest) PathParams() *SendDimensionValuesPathParams {
+	return r.pathParams
+}
+
+func (r *SendDimensionValuesRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SendDimensionValuesRequest) Method() string {
+	return r.method
+}
+
+func (r SendDimensionValuesRequest) NewSendDimensionValuesRequestBody() SendDimensionValuesRequestBody {
+	return SendDimensionValuesRequestBody{}
+}
+
+type SendDimensionValuesRequestBody struct {
+	Values NewDimensionValues `json:"Values"`
+}
+
+func (r *SendDimensionValuesRequest) RequestBody() *SendDimensionValuesRequestBody {
+	return &r.requestBody
+}
+
+func (r *SendDimensionValuesRequest) SetRequestBody(body SendDimensionValuesRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SendDimensionValuesRequest) NewResponseBody() *SendDimensionValuesResponseBody {
+	return &SendDimensionValuesResponseBody{}
+}
+
+type SendDimensionValuesResponseBody []struct {
+	ID   uuid.UUID `json:"Id"`
+	Code string    `json:"Code"`
+}
+
+func (r *SendDimensionValuesRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("senddimvalues", r.PathParams())
+}
+
+func (r *SendDimensionValuesRequest) Do(ctx context.Context, opts ...RequestOption) (SendDimensionValuesResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type NewDimensionValues []NewDimensionValue
+
+type NewDimensionValue struct {
+	// Required
+	DimID int `json:"DimId"`
+	// Required
+	Code string `json:"Code"`
+	// Required
+	Name    string `json:"Name"`
+	EndDate Date   `json:"EndDate"`
+}
@@ -0,0 +1,34 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestSendDimensionValues(t *testing.T) {
+	b := []byte(`
+		{
+			"Values": [{
+				"DimId": 1,
+				"Code": "WEB",
+				"Name": "Web shop"
+			}]
+		}
+	`)
+
+	req := client.NewSendDimensionValuesRequest()
+	err := json.Unmarshal(b, req.RequestBody())
+	if err != nil {
+		t.Error(err)
+	}
+
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ = json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,195 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewSendFixedAssetRequest() SendFixedAssetRequest {
+	r := SendFixedAssetRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewSendFixedAssetQueryParams()
+	r.pathParams = r.NewSendFixedAssetPathParams()
+	r.requestBody = r.NewSendFixedAssetRequestBody()
+	return r
+}
+
+type SendFixedAssetRequest struct {
+	client      *Client
+	queryParams *SendFixedAssetQueryParams
+	pathParams  *SendFixedAssetPathParams
+	method      string
+	headers     http.Header
+	requestBody SendFixedAssetRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r SendFixedAssetRequest) Clone() SendFixedAssetRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r SendFixedAssetRequest) NewSendFixedAssetQueryParams() *SendFixedAssetQueryParams {
+	return &SendFixedAssetQueryParams{}
+}
+
+type SendFixedAssetQueryParams struct {
+}
+
+func (p SendFixedAssetQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SendFixedAssetRequest) QueryParams() *SendFixedAssetQueryParams {
+	return r.queryParams
+}
+
+func (r SendFixedAssetRequest) NewSendFixedAssetPathParams() *SendFixedAssetPathParams {
+	return &SendFixedAssetPathParams{}
+}
+
+type SendFixedAssetPathParams struct {
+}
+
+func (p *SendFixedAssetPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *SendFixedAssetRequest) PathParams() *SendFixedAssetPathParams {
+	return r.pathParams
+}
+
+func (r *SendFixedAssetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SendFixedAssetRequest) Method() string {
+	return r.method
+}
+
+func (r SendFixedAssetRequest) NewSendFixedAssetRequestBody() SendFixedAssetRequestBody {
+	return SendFixedAssetRequestBody{}
+}
+
+type SendFixedAssetRequestBody NewFixedAsset
+
+func (r *SendFixedAssetRequest) RequestBody() *SendFixedAssetRequestBody {
+	return &r.requestBody
+}
+
+func (r *SendFixedAssetRequest) SetRequestBody(body SendFixedAssetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SendFixedAssetRequest) NewResponseBody() *SendFixedAssetResponseBody {
+	return &SendFixedAssetResponseBody{}
+}
+
+type SendFixedAssetResponseBody struct {
+	ID uuid.UUID `json:"Id"`
+}
+
+func (r *SendFixedAssetRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("sendfixedasset", r.PathParams())
+}
+
+func (r *SendFixedAssetRequest) Do(ctx context.Context, opts ...RequestOption) (SendFixedAssetResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type NewFixedAsset struct {
+	// Required
+	Code string `json:"Code"`
+	// Required
+	Name      string `json:"Name"`
+	GroupName string `json:"GroupName,omitempty"`
+	// Required
+	AcquisitionDate Date `json:"AcquisitionDate"`
+	// Required
+	AcquisitionCost Decimal  `json:"AcquisitionCost"`
+	ResidualValue   *Decimal `json:"ResidualValue,omitempty"`
+	// Yearly depreciation percentage
+	DepreciationPct   *Decimal `json:"DepreciationPct,omitempty"`
+	LocationName      string   `json:"LocationName,omitempty"`
+	ResponsiblePerson string   `json:"ResponsiblePerson,omitempty"`
+	DepartmentCode    string   `json:"DepartmentCode,omitempty"`
+	// Purchase invoice the asset was capitalized from
+	PurchaseInvoiceID *uuid.UUID `json:"PurchaseInvoiceId,omitempty"`
+}
+
+// NewDepreciationGLBatch returns the journal entry posting a depreciation of
+// amount for asset: debit the depreciation expense account, credit the
+// accumulated depreciation account. Merit doesn't offer an endpoint to run
+// the depreciation itself, send the entry with SendGLBatch.
+func NewDepreciationGLBatch(asset FixedAsset, amount Decimal, date Date, expenseAccount, accumulatedAccount string) NewGLBatch {
+	return NewGLBatch{
+		DocNo:     asset.Code,
+		BatchDate: date,
+		EntryRow: []EntryRow{
+			{
+				AccountCode:    expenseAccount,
+				DepartmentCode: asset.DepartmentCode,
+				Debit:          amount,
+				Memo:           asset.Name,
+			},
+			{
+				AccountCode:    accumulatedAccount,
+				DepartmentCode: asset.DepartmentCode,
+				Credit:         amount,
+				Memo:           asset.Name,
+			},
+		},
+	}
+}
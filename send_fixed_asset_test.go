@@ -0,0 +1,34 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestSendFixedAsset(t *testing.T) {
+	b := []byte(`
+		{
+			"Code": "LAPTOP-001",
+			"Name": "Laptop",
+			"AcquisitionDate": "20200101",
+			"AcquisitionCost": 1500,
+			"DepreciationPct": 33
+		}
+	`)
+
+	req := client.NewSendFixedAssetRequest()
+	err := json.Unmarshal(b, req.RequestBody())
+	if err != nil {
+		t.Error(err)
+	}
+
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ = json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
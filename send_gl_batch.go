@@ -1,6 +1,8 @@
 package aktiva
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"net/url"
 
@@ -30,6 +32,22 @@ type SendGLBatchRequest struct {
 	requestBody SendGLBatchRequestBody
 }
 
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r SendGLBatchRequest) Clone() SendGLBatchRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
 func (r SendGLBatchRequest) NewSendGLBatchQueryParams() *SendGLBatchQueryParams {
 	return &SendGLBatchQueryParams{}
 }
@@ -37,7 +55,7 @@ func (r SendGLBatchRequest) NewSendGLBatchQueryParams() *SendGLBatchQueryParams
 type SendGLBatchQueryParams struct{}
 
 func (p SendGLBatchQueryParams) ToURLValues() (url.Values, error) {
-	encoder := utils.NewSchemaEncoder()
+	encoder := NewSchemaEncoder()
 	params := url.Values{}
 
 	err := encoder.Encode(p, params)
@@ -98,19 +116,37 @@ type SendGLBatchResponseBody struct {
 	BatchInfo string    `json:"BatchInfo"`
 }
 
-func (r *SendGLBatchRequest) URL() url.URL {
+func (r *SendGLBatchRequest) URL() (url.URL, error) {
 	return r.client.GetEndpointURL("sendglbatch", r.PathParams())
 }
 
-func (r *SendGLBatchRequest) Do() (SendGLBatchResponseBody, error) {
+func (r *SendGLBatchRequest) Do(ctx context.Context, opts ...RequestOption) (SendGLBatchResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	// Don't bother Merit with entries that don't balance
+	err := NewGLBatch(*snapshot.RequestBody()).Validate()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
 	// Create http request
-	req, err := r.client.NewRequest(nil, r.Method(), r.URL(), r.RequestBody())
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
 
 	// Process query parameters
-	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
@@ -126,11 +162,49 @@ type NewGLBatch struct {
 	EntryRow  []EntryRow
 }
 
+// Validate checks that the entry has at least two rows, that every row has an
+// account and either a debit or a credit amount and that the entry balances
+func (b NewGLBatch) Validate() error {
+	if len(b.EntryRow) < 2 {
+		return fmt.Errorf("GL batch %s: expected at least 2 entry rows, got %d", b.DocNo, len(b.EntryRow))
+	}
+
+	debit, credit := Decimal{}, Decimal{}
+	for i, row := range b.EntryRow {
+		if row.AccountCode == "" {
+			return fmt.Errorf("GL batch %s: entry row %d has no account code", b.DocNo, i)
+		}
+
+		if !row.Debit.IsZero() && !row.Credit.IsZero() {
+			return fmt.Errorf("GL batch %s: entry row %d has both a debit and a credit amount", b.DocNo, i)
+		}
+		if row.Debit.IsZero() && row.Credit.IsZero() {
+			return fmt.Errorf("GL batch %s: entry row %d has neither a debit nor a credit amount", b.DocNo, i)
+		}
+
+		debit = debit.Add(row.Debit)
+		credit = credit.Add(row.Credit)
+	}
+
+	// Merit books the amounts in cents
+	if !debit.Round(2).Equal(credit.Round(2)) {
+		return fmt.Errorf("GL batch %s doesn't balance: debit %s, credit %s", b.DocNo, debit.StringFixed(2), credit.StringFixed(2))
+	}
+
+	return nil
+}
+
 type EntryRow struct {
 	AccountCode    string
 	DepartmentCode string `json:"DepartmentCode,omitempty"`
-	Debit          float64
-	Credit         float64
+	Debit          Decimal
+	Credit         Decimal
 	ProjectCode    string `json:"ProjectCode,omitempty"`
 	CostCenterCode string `json:"CostCenterCode,omitempty"`
+	// Use gettaxes endpoint to detect the guid needed
+	TaxID *uuid.UUID `json:"TaxId,omitempty"`
+	// VAT amount of the row, required when TaxId is filled
+	TaxAmount  *Decimal      `json:"TaxAmount,omitempty"`
+	Memo       string        `json:"Memo,omitempty"`
+	Dimensions RowDimensions `json:"Dimensions,omitempty"`
 }
@@ -1,9 +1,12 @@
 package aktiva_test
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"testing"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
 )
 
 func TestSendGLBatch(t *testing.T) {
@@ -38,7 +41,7 @@ func TestSendGLBatch(t *testing.T) {
 		t.Error(err)
 	}
 
-	resp, err := req.Do()
+	resp, err := req.Do(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -46,3 +49,33 @@ func TestSendGLBatch(t *testing.T) {
 	b, _ = json.MarshalIndent(resp, "", "  ")
 	log.Println(string(b))
 }
+
+func TestNewGLBatchValidate(t *testing.T) {
+	batch := aktiva.NewGLBatch{
+		DocNo: "TEST",
+		EntryRow: []aktiva.EntryRow{
+			{AccountCode: "1340", Credit: aktiva.MustParseDecimal("100.10")},
+			{AccountCode: "1000", Debit: aktiva.MustParseDecimal("50.05")},
+			{AccountCode: "1000", Debit: aktiva.MustParseDecimal("50.05")},
+		},
+	}
+
+	err := batch.Validate()
+	if err != nil {
+		t.Error(err)
+	}
+
+	batch.EntryRow[2].Debit = aktiva.NewDecimal(50, 0)
+	err = batch.Validate()
+	if err == nil {
+		t.Error("expected error for unbalanced batch")
+	}
+
+	// balances, but the last row has no amount
+	batch.EntryRow[2].Debit = aktiva.MustParseDecimal("50.05")
+	batch.EntryRow = append(batch.EntryRow, aktiva.EntryRow{AccountCode: "1000"})
+	err = batch.Validate()
+	if err == nil {
+		t.Error("expected error for a row without amounts")
+	}
+}
@@ -1,6 +1,9 @@
 package aktiva
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 
@@ -30,6 +33,22 @@ type SendInvoiceRequest struct {
 	requestBody SendInvoiceRequestBody
 }
 
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r SendInvoiceRequest) Clone() SendInvoiceRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
 func (r SendInvoiceRequest) NewSendInvoiceQueryParams() *SendInvoiceQueryParams {
 	return &SendInvoiceQueryParams{}
 }
@@ -37,7 +56,7 @@ func (r SendInvoiceRequest) NewSendInvoiceQueryParams() *SendInvoiceQueryParams
 type SendInvoiceQueryParams struct{}
 
 func (p SendInvoiceQueryParams) ToURLValues() (url.Values, error) {
-	encoder := utils.NewSchemaEncoder()
+	encoder := NewSchemaEncoder()
 	params := url.Values{}
 
 	err := encoder.Encode(p, params)
@@ -104,19 +123,31 @@ type SendInvoiceResponseBody struct {
 	NewCustomer interface{} `json:"NewCustomer"`
 }
 
-func (r *SendInvoiceRequest) URL() url.URL {
+func (r *SendInvoiceRequest) URL() (url.URL, error) {
 	return r.client.GetEndpointURL("sendinvoice", r.PathParams())
 }
 
-func (r *SendInvoiceRequest) Do() (SendInvoiceResponseBody, error) {
+func (r *SendInvoiceRequest) Do(ctx context.Context, opts ...RequestOption) (SendInvoiceResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
 	// Create http request
-	req, err := r.client.NewRequest(nil, r.Method(), r.URL(), r.RequestBody())
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
 
 	// Process query parameters
-	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
@@ -127,21 +158,30 @@ func (r *SendInvoiceRequest) Do() (SendInvoiceResponseBody, error) {
 }
 
 type NewInvoice struct {
-	Customer       NewInvoiceCustomer
-	DocDate        Date
-	DueDate        Date
-	InvoiceNo      string
-	RefNo          string
-	CurrencyCode   string
+	Customer     NewInvoiceCustomer
+	DocDate      Date
+	DueDate      Date
+	InvoiceNo    string
+	RefNo        string
+	CurrencyCode string
+	// Exchange rate of CurrencyCode against the company currency, taken from
+	// the currency register when empty. See GetCurrencyRatesRequest.
+	CurrencyRate   *Decimal `json:",omitempty"`
 	DepartmentCode string
 	ProjectCode    string
 	InvoiceRow     InvoiceRows
 	TaxAmount      TaxAmounts
-	RoundingAmount float64
-	TotalAmount    float64
+	RoundingAmount Decimal
+	TotalAmount    Decimal
 	Payment        *Payment
-	Hcomment       string
-	Fcomment       string
+	// Prepayment invoices deducted on this (final) invoice, the VAT of the
+	// prepayments is deducted from the VAT of this invoice
+	Prepayments PrepaymentLinks `json:",omitempty"`
+	// Name of the salesperson, must match a user. Use Users.FindByEmail to
+	// look it up.
+	SalesmanName string `json:",omitempty"`
+	Hcomment     string
+	Fcomment     string
 }
 
 type NewInvoiceCustomer struct {
@@ -160,11 +200,11 @@ type NewInvoiceCustomer struct {
 	// If missing then taken from default settings.
 	PaymentDeadLine int `json:"PaymentDeadLine,omitempty"`
 	// If missing then taken from default settings.
-	OverDueCharge float64 `json:"OverDueCharge,omitempty"`
-	Address       string  `json:"Address,omitempty"`
-	City          string  `json:"City,omitempty"`
-	Country       string  `json:"Country,omitempty"`
-	PostalCode    string  `json:"PostalCode,omitempty"`
+	OverDueCharge *Decimal `json:"OverDueCharge,omitempty"`
+	Address       string   `json:"Address,omitempty"`
+	City          string   `json:"City,omitempty"`
+	Country       string   `json:"Country,omitempty"`
+	PostalCode    string   `json:"PostalCode,omitempty"`
 	// Required when adding
 	CountryCode string
 	PhoneNo     string `json:"PhoneNo,omitempty"`
@@ -179,17 +219,97 @@ type InvoiceRows []InvoiceRow
 
 type InvoiceRow struct {
 	Item           Article
-	Quantity       float64
-	Price          float64
-	DiscountPct    float64
-	DiscountAmount float64
+	Quantity       Decimal
+	Price          Decimal
+	DiscountPct    Decimal
+	DiscountAmount Decimal
 	TaxID          uuid.UUID `json:"TaxId"`
 	LocationCode   string
 	DepartmentCode string
-	ItemCostAmount float64
-	GLAccountCode  string
+	ItemCostAmount Decimal
+	// Overrides the sales account of the item for this row. Must exist in the
+	// chart of accounts, see InvoiceRows.ValidateGLAccounts.
+	GLAccountCode  string `json:"GLAccountCode,omitempty"`
 	ProjectCode    string
 	CostCenterCode string
+	Dimensions     RowDimensions `json:"Dimensions,omitempty"`
+}
+
+// NewTextInvoiceRow returns a description only row, used for comments between
+// the regular invoice rows
+func NewTextInvoiceRow(description string) InvoiceRow {
+	return InvoiceRow{Item: Article{Description: description}}
+}
+
+// NewServiceInvoiceRow returns a row for a service item without a quantity and
+// without stock impact
+func NewServiceInvoiceRow(code, description string, price Decimal, taxID uuid.UUID) InvoiceRow {
+	return InvoiceRow{
+		Item: Article{
+			Code:        code,
+			Description: description,
+			Type:        ItemTypeService,
+		},
+		Price: price,
+		TaxID: taxID,
+	}
+}
+
+// IsTextRow reports whether the row only consists of a description
+func (r InvoiceRow) IsTextRow() bool {
+	return r.Item.Code == "" && r.Item.Description != "" &&
+		r.Quantity.IsZero() && r.Price.IsZero() && r.DiscountAmount.IsZero() &&
+		r.TaxID == uuid.Nil
+}
+
+// IsQuantityFreeServiceRow reports whether the row is a service row without a
+// quantity
+func (r InvoiceRow) IsQuantityFreeServiceRow() bool {
+	return r.Item.Type == ItemTypeService && r.Quantity.IsZero()
+}
+
+// MarshalJSON only sends the description for text rows and leaves out the
+// quantity and stock fields for quantity free service rows, the same way the
+// Merit UI stores them
+func (r InvoiceRow) MarshalJSON() ([]byte, error) {
+	type alias InvoiceRow
+
+	if r.IsTextRow() {
+		return json.Marshal(struct {
+			Item struct {
+				Description string
+			}
+		}{
+			Item: struct{ Description string }{r.Item.Description},
+		})
+	}
+
+	if r.IsQuantityFreeServiceRow() {
+		return json.Marshal(struct {
+			alias
+			Quantity       *Decimal `json:"Quantity,omitempty"`
+			LocationCode   string   `json:"LocationCode,omitempty"`
+			ItemCostAmount *Decimal `json:"ItemCostAmount,omitempty"`
+		}{alias: alias(r)})
+	}
+
+	return json.Marshal(alias(r))
+}
+
+// ValidateGLAccounts checks that every row level GL account override is
+// present in the chart of accounts
+func (rows InvoiceRows) ValidateGLAccounts(accounts Accounts) error {
+	for i, row := range rows {
+		if row.GLAccountCode == "" {
+			continue
+		}
+
+		if _, ok := accounts.FindByCode(row.GLAccountCode); !ok {
+			return fmt.Errorf("invoice row %d: unknown GL account code \"%s\"", i, row.GLAccountCode)
+		}
+	}
+
+	return nil
 }
 
 type Article struct {
@@ -197,11 +317,8 @@ type Article struct {
 	Code string
 	// Required
 	Description string
-	// 1 = stock item
-	// 2 = service
-	// 3 = item
 	// Required.
-	Type int
+	Type ItemType
 	// Name for the unit
 	UOMName string `json:"UOMName,omitempty"`
 	// If company has more than one (default) stock, stock code in this field is
@@ -214,12 +331,26 @@ type TaxAmounts []TaxAmount
 type TaxAmount struct {
 	// Required. Use gettaxes endpoint to detect the guid needed
 	TaxID  uuid.UUID `json:"TaxId"`
-	Amount float64
+	Amount Decimal
+}
+
+type PrepaymentLinks []PrepaymentLink
+
+// PrepaymentLink deducts (part of) a prepayment invoice on a final invoice
+type PrepaymentLink struct {
+	// Number of the prepayment invoice
+	InvoiceNo string
+	// Amount of the prepayment used, including VAT
+	Amount Decimal
 }
 
 type Payment struct {
 	// Name of the payment method. Must be found in the company database.
 	PaymentMethod string
-	PaidAmount    float64
+	PaidAmount    Decimal
 	PaymDate      Date
+	// Currency of the payment when it differs from the invoice currency
+	CurrencyCode string `json:",omitempty"`
+	// Exchange rate of CurrencyCode against the company currency
+	CurrencyRate *Decimal `json:",omitempty"`
 }
@@ -1,9 +1,13 @@
 package aktiva_test
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"testing"
+
+	"github.com/gofrs/uuid"
+	aktiva "github.com/omniboost/go-merit-aktiva"
 )
 
 func TestSendInvoice(t *testing.T) {
@@ -63,7 +67,7 @@ func TestSendInvoice(t *testing.T) {
 		t.Error(err)
 	}
 
-	resp, err := req.Do()
+	resp, err := req.Do(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -71,3 +75,55 @@ func TestSendInvoice(t *testing.T) {
 	b, _ = json.MarshalIndent(resp, "", "  ")
 	log.Println(string(b))
 }
+
+func TestInvoiceRowsValidateGLAccounts(t *testing.T) {
+	accounts := aktiva.Accounts{
+		{Code: "3000", Name: "Sales"},
+		{Code: "3060", Name: "Sales of services"},
+	}
+
+	rows := aktiva.InvoiceRows{
+		{GLAccountCode: "3060"},
+		{},
+	}
+	err := rows.ValidateGLAccounts(accounts)
+	if err != nil {
+		t.Error(err)
+	}
+
+	rows = append(rows, aktiva.InvoiceRow{GLAccountCode: "9999"})
+	err = rows.ValidateGLAccounts(accounts)
+	if err == nil {
+		t.Error("expected error for unknown GL account code")
+	}
+}
+
+func TestInvoiceRowMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(aktiva.NewTextInvoiceRow("Delivered on site"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"Item":{"Description":"Delivered on site"}}` {
+		t.Errorf("unexpected text row: %s", b)
+	}
+
+	row := aktiva.NewServiceInvoiceRow("CONSULT", "Consultancy", aktiva.NewDecimal(95, 0), uuid.Must(uuid.NewV4()))
+	b, err = json.Marshal(row)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := map[string]interface{}{}
+	err = json.Unmarshal(b, &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, k := range []string{"Quantity", "LocationCode", "ItemCostAmount"} {
+		if _, ok := m[k]; ok {
+			t.Errorf("service row should not contain %s: %s", k, b)
+		}
+	}
+	if m["Price"] != float64(95) {
+		t.Errorf("service row should contain price: %s", b)
+	}
+}
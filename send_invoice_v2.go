@@ -0,0 +1,160 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewSendInvoiceV2Request() SendInvoiceV2Request {
+	r := SendInvoiceV2Request{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewSendInvoiceV2QueryParams()
+	r.pathParams = r.NewSendInvoiceV2PathParams()
+	r.requestBody = r.NewSendInvoiceV2RequestBody()
+	return r
+}
+
+type SendInvoiceV2Request struct {
+	client      *Client
+	queryParams *SendInvoiceV2QueryParams
+	pathParams  *SendInvoiceV2PathParams
+	method      string
+	headers     http.Header
+	requestBody SendInvoiceV2RequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r SendInvoiceV2Request) Clone() SendInvoiceV2Request {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r SendInvoiceV2Request) NewSendInvoiceV2QueryParams() *SendInvoiceV2QueryParams {
+	return &SendInvoiceV2QueryParams{}
+}
+
+type SendInvoiceV2QueryParams struct {
+}
+
+func (p SendInvoiceV2QueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SendInvoiceV2Request) QueryParams() *SendInvoiceV2QueryParams {
+	return r.queryParams
+}
+
+func (r SendInvoiceV2Request) NewSendInvoiceV2PathParams() *SendInvoiceV2PathParams {
+	return &SendInvoiceV2PathParams{}
+}
+
+type SendInvoiceV2PathParams struct {
+}
+
+func (p *SendInvoiceV2PathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *SendInvoiceV2Request) PathParams() *SendInvoiceV2PathParams {
+	return r.pathParams
+}
+
+func (r *SendInvoiceV2Request) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SendInvoiceV2Request) Method() string {
+	return r.method
+}
+
+func (r SendInvoiceV2Request) NewSendInvoiceV2RequestBody() SendInvoiceV2RequestBody {
+	return SendInvoiceV2RequestBody{}
+}
+
+type SendInvoiceV2RequestBody NewInvoiceV2
+
+func (r *SendInvoiceV2Request) RequestBody() *SendInvoiceV2RequestBody {
+	return &r.requestBody
+}
+
+func (r *SendInvoiceV2Request) SetRequestBody(body SendInvoiceV2RequestBody) {
+	r.requestBody = body
+}
+
+func (r *SendInvoiceV2Request) NewResponseBody() *SendInvoiceV2ResponseBody {
+	return &SendInvoiceV2ResponseBody{}
+}
+
+type SendInvoiceV2ResponseBody SendInvoiceResponseBody
+
+func (r *SendInvoiceV2Request) URL() (url.URL, error) {
+	return r.client.GetVersionedEndpointURL(APIVersion2, "sendinvoice", r.PathParams())
+}
+
+func (r *SendInvoiceV2Request) Do(ctx context.Context, opts ...RequestOption) (SendInvoiceV2ResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+// NewInvoiceV2 is the v2 schema of a sales invoice, v1 with extra fields
+type NewInvoiceV2 struct {
+	NewInvoice
+	// Number of the contract the invoice belongs to
+	ContractNo string `json:",omitempty"`
+	// Language of the invoice printout: "et", "en", "fi", "pl" or "ru"
+	InvoiceLanguage string `json:",omitempty"`
+	// Reserve the stock items on the invoice rows
+	ReserveItems bool `json:",omitempty"`
+	// Delivery address when it differs from the customer's address
+	DeliveryAddress string `json:",omitempty"`
+}
@@ -0,0 +1,48 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestSendInvoiceV2(t *testing.T) {
+	b := []byte(`
+		{
+			"Customer": {
+				"Name": "Omniboost B.V.",
+				"NotTDCustomer": true,
+				"CountryCode": "NL"
+			},
+			"DocDate": "20200115",
+			"DueDate": "20200122",
+			"InvoiceNo": "V2-0001",
+			"InvoiceLanguage": "en",
+			"InvoiceRow": [
+				{
+					"Item": {"Code": "CONSULT", "Description": "Consultancy", "Type": 2},
+					"Quantity": 1,
+					"Price": 100,
+					"TaxId": "b9b25735-6a15-4d4e-8720-25b254ae3d21"
+				}
+			],
+			"TaxAmount": [{"TaxId": "b9b25735-6a15-4d4e-8720-25b254ae3d21", "Amount": 20}],
+			"TotalAmount": 100
+		}
+	`)
+
+	req := client.NewSendInvoiceV2Request()
+	err := json.Unmarshal(b, req.RequestBody())
+	if err != nil {
+		t.Error(err)
+	}
+
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ = json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
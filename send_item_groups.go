@@ -0,0 +1,162 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewSendItemGroupsRequest() SendItemGroupsRequest {
+	r := SendItemGroupsRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewSendItemGroupsQueryParams()
+	r.pathParams = r.NewSendItemGroupsPathParams()
+	r.requestBody = r.NewSendItemGroupsRequestBody()
+	return r
+}
+
+type SendItemGroupsRequest struct {
+	client      *Client
+	queryParams *SendItemGroupsQueryParams
+	pathParams  *SendItemGroupsPathParams
+	method      string
+	headers     http.Header
+	requestBody SendItemGroupsRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r SendItemGroupsRequest) Clone() SendItemGroupsRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r SendItemGroupsRequest) NewSendItemGroupsQueryParams() *SendItemGroupsQueryParams {
+	return &SendItemGroupsQueryParams{}
+}
+
+type SendItemGroupsQueryParams struct {
+}
+
+func (p SendItemGroupsQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SendItemGroupsRequest) QueryParams() *SendItemGroupsQueryParams {
+	return r.queryParams
+}
+
+func (r SendItemGroupsRequest) NewSendItemGroupsPathParams() *SendItemGroupsPathParams {
+	return &SendItemGroupsPathParams{}
+}
+
+type SendItemGroupsPathParams struct {
+}
+
+func (p *SendItemGroupsPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *SendItemGroupsRequest) PathParams() *SendItemGroupsPathParams {
+	return r.pathParams
+}
+
+func (r *SendItemGroupsRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SendItemGroupsRequest) Method() string {
+	return r.method
+}
+
+func (r SendItemGroupsRequest) NewSendItemGroupsRequestBody() SendItemGroupsRequestBody {
+	return SendItemGroupsRequestBody{}
+}
+
+type SendItemGroupsRequestBody struct {
+	ItemGroups NewItemGroups `json:"ItemGroups"`
+}
+
+func (r *SendItemGroupsRequest) RequestBody() *SendItemGroupsRequestBody {
+	return &r.requestBody
+}
+
+func (r *SendItemGroupsRequest) SetRequestBody(body SendItemGroupsRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SendItemGroupsRequest) NewResponseBody() *SendItemGroupsResponseBody {
+	return &SendItemGroupsResponseBody{}
+}
+
+type SendItemGroupsResponseBody []struct {
+	ID   uuid.UUID `json:"Id"`
+	Code string    `json:"Code"`
+}
+
+func (r *SendItemGroupsRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("senditemgroups", r.PathParams())
+}
+
+func (r *SendItemGroupsRequest) Do(ctx context.Context, opts ...RequestOption) (SendItemGroupsResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type NewItemGroups []NewItemGroup
+
+type NewItemGroup struct {
+	// Required
+	Code string `json:"Code"`
+	// Required
+	Name string `json:"Name"`
+}
@@ -0,0 +1,33 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestSendItemGroups(t *testing.T) {
+	b := []byte(`
+		{
+			"ItemGroups": [{
+				"Code": "SRV",
+				"Name": "Services"
+			}]
+		}
+	`)
+
+	req := client.NewSendItemGroupsRequest()
+	err := json.Unmarshal(b, req.RequestBody())
+	if err != nil {
+		t.Error(err)
+	}
+
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ = json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
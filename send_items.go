@@ -0,0 +1,177 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewSendItemsRequest() SendItemsRequest {
+	r := SendItemsRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewSendItemsQueryParams()
+	r.pathParams = r.NewSendItemsPathParams()
+	r.requestBody = r.NewSendItemsRequestBody()
+	return r
+}
+
+type SendItemsRequest struct {
+	client      *Client
+	queryParams *SendItemsQueryParams
+	pathParams  *SendItemsPathParams
+	method      string
+	headers     http.Header
+	requestBody SendItemsRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r SendItemsRequest) Clone() SendItemsRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r SendItemsRequest) NewSendItemsQueryParams() *SendItemsQueryParams {
+	return &SendItemsQueryParams{}
+}
+
+type SendItemsQueryParams struct {
+}
+
+func (p SendItemsQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SendItemsRequest) QueryParams() *SendItemsQueryParams {
+	return r.queryParams
+}
+
+func (r SendItemsRequest) NewSendItemsPathParams() *SendItemsPathParams {
+	return &SendItemsPathParams{}
+}
+
+type SendItemsPathParams struct {
+}
+
+func (p *SendItemsPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *SendItemsRequest) PathParams() *SendItemsPathParams {
+	return r.pathParams
+}
+
+func (r *SendItemsRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SendItemsRequest) Method() string {
+	return r.method
+}
+
+func (r SendItemsRequest) NewSendItemsRequestBody() SendItemsRequestBody {
+	return SendItemsRequestBody{}
+}
+
+type SendItemsRequestBody struct {
+	Items NewItems `json:"Items"`
+}
+
+func (r *SendItemsRequest) RequestBody() *SendItemsRequestBody {
+	return &r.requestBody
+}
+
+func (r *SendItemsRequest) SetRequestBody(body SendItemsRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SendItemsRequest) NewResponseBody() *SendItemsResponseBody {
+	return &SendItemsResponseBody{}
+}
+
+type SendItemsResponseBody []struct {
+	ItemID uuid.UUID `json:"ItemId"`
+	Code   string    `json:"Code"`
+}
+
+func (r *SendItemsRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("senditems", r.PathParams())
+}
+
+func (r *SendItemsRequest) Do(ctx context.Context, opts ...RequestOption) (SendItemsResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type NewItems []NewItem
+
+type NewItem struct {
+	// Required.
+	Type ItemType `json:"Type"`
+	// Required
+	Code string `json:"Code"`
+	// Required
+	Description string `json:"Description"`
+	// Name for the unit
+	UOMName string `json:"UOMName,omitempty"`
+	// Default sales price
+	SalesPrice *Decimal `json:"SalesPrice,omitempty"`
+	// Use gettaxes endpoint to detect the guid needed
+	TaxID                *uuid.UUID `json:"TaxId,omitempty"`
+	ItemGroupName        string     `json:"ItemGroupName,omitempty"`
+	SalesAccountCode     string     `json:"SalesAccountCode,omitempty"`
+	PurchaseAccountCode  string     `json:"PurchaseAccountCode,omitempty"`
+	InventoryAccountCode string     `json:"InventoryAccountCode,omitempty"`
+	CostAccountCode      string     `json:"CostAccountCode,omitempty"`
+	// Translated item names, keyed by language code
+	Descriptions ItemDescriptions `json:"Descriptions,omitempty"`
+}
@@ -0,0 +1,37 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestSendItems(t *testing.T) {
+	b := []byte(`
+		{
+			"Items": [{
+				"Type": 2,
+				"Code": "CONSULT",
+				"Description": "Consultancy",
+				"UOMName": "h",
+				"SalesPrice": 95,
+				"SalesAccountCode": "3060"
+			}]
+		}
+	`)
+
+	req := client.NewSendItemsRequest()
+	err := json.Unmarshal(b, req.RequestBody())
+	if err != nil {
+		t.Error(err)
+	}
+
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ = json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
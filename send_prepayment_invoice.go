@@ -0,0 +1,147 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewSendPrepaymentInvoiceRequest() SendPrepaymentInvoiceRequest {
+	r := SendPrepaymentInvoiceRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewSendPrepaymentInvoiceQueryParams()
+	r.pathParams = r.NewSendPrepaymentInvoicePathParams()
+	r.requestBody = r.NewSendPrepaymentInvoiceRequestBody()
+	return r
+}
+
+type SendPrepaymentInvoiceRequest struct {
+	client      *Client
+	queryParams *SendPrepaymentInvoiceQueryParams
+	pathParams  *SendPrepaymentInvoicePathParams
+	method      string
+	headers     http.Header
+	requestBody SendPrepaymentInvoiceRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r SendPrepaymentInvoiceRequest) Clone() SendPrepaymentInvoiceRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r SendPrepaymentInvoiceRequest) NewSendPrepaymentInvoiceQueryParams() *SendPrepaymentInvoiceQueryParams {
+	return &SendPrepaymentInvoiceQueryParams{}
+}
+
+type SendPrepaymentInvoiceQueryParams struct {
+}
+
+func (p SendPrepaymentInvoiceQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SendPrepaymentInvoiceRequest) QueryParams() *SendPrepaymentInvoiceQueryParams {
+	return r.queryParams
+}
+
+func (r SendPrepaymentInvoiceRequest) NewSendPrepaymentInvoicePathParams() *SendPrepaymentInvoicePathParams {
+	return &SendPrepaymentInvoicePathParams{}
+}
+
+type SendPrepaymentInvoicePathParams struct {
+}
+
+func (p *SendPrepaymentInvoicePathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *SendPrepaymentInvoiceRequest) PathParams() *SendPrepaymentInvoicePathParams {
+	return r.pathParams
+}
+
+func (r *SendPrepaymentInvoiceRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SendPrepaymentInvoiceRequest) Method() string {
+	return r.method
+}
+
+func (r SendPrepaymentInvoiceRequest) NewSendPrepaymentInvoiceRequestBody() SendPrepaymentInvoiceRequestBody {
+	return SendPrepaymentInvoiceRequestBody{}
+}
+
+type SendPrepaymentInvoiceRequestBody NewInvoice
+
+func (r *SendPrepaymentInvoiceRequest) RequestBody() *SendPrepaymentInvoiceRequestBody {
+	return &r.requestBody
+}
+
+func (r *SendPrepaymentInvoiceRequest) SetRequestBody(body SendPrepaymentInvoiceRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SendPrepaymentInvoiceRequest) NewResponseBody() *SendPrepaymentInvoiceResponseBody {
+	return &SendPrepaymentInvoiceResponseBody{}
+}
+
+type SendPrepaymentInvoiceResponseBody SendInvoiceResponseBody
+
+func (r *SendPrepaymentInvoiceRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("sendprepaymentinvoice", r.PathParams())
+}
+
+func (r *SendPrepaymentInvoiceRequest) Do(ctx context.Context, opts ...RequestOption) (SendPrepaymentInvoiceResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
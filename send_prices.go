@@ -0,0 +1,166 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewSendPricesRequest() SendPricesRequest {
+	r := SendPricesRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewSendPricesQueryParams()
+	r.pathParams = r.NewSendPricesPathParams()
+	r.requestBody = r.NewSendPricesRequestBody()
+	return r
+}
+
+type SendPricesRequest struct {
+	client      *Client
+	queryParams *SendPricesQueryParams
+	pathParams  *SendPricesPathParams
+	method      string
+	headers     http.Header
+	requestBody SendPricesRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r SendPricesRequest) Clone() SendPricesRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r SendPricesRequest) NewSendPricesQueryParams() *SendPricesQueryParams {
+	return &SendPricesQueryParams{}
+}
+
+type SendPricesQueryParams struct {
+}
+
+func (p SendPricesQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SendPricesRequest) QueryParams() *SendPricesQueryParams {
+	return r.queryParams
+}
+
+func (r SendPricesRequest) NewSendPricesPathParams() *SendPricesPathParams {
+	return &SendPricesPathParams{}
+}
+
+type SendPricesPathParams struct {
+}
+
+func (p *SendPricesPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *SendPricesRequest) PathParams() *SendPricesPathParams {
+	return r.pathParams
+}
+
+func (r *SendPricesRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SendPricesRequest) Method() string {
+	return r.method
+}
+
+func (r SendPricesRequest) NewSendPricesRequestBody() SendPricesRequestBody {
+	return SendPricesRequestBody{}
+}
+
+type SendPricesRequestBody struct {
+	Prices NewPrices `json:"Prices"`
+}
+
+func (r *SendPricesRequest) RequestBody() *SendPricesRequestBody {
+	return &r.requestBody
+}
+
+func (r *SendPricesRequest) SetRequestBody(body SendPricesRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SendPricesRequest) NewResponseBody() *SendPricesResponseBody {
+	return &SendPricesResponseBody{}
+}
+
+type SendPricesResponseBody struct{}
+
+func (r *SendPricesRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("sendprices", r.PathParams())
+}
+
+func (r *SendPricesRequest) Do(ctx context.Context, opts ...RequestOption) (SendPricesResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type NewPrices []NewPrice
+
+type NewPrice struct {
+	// Required
+	ItemCode string `json:"ItemCode"`
+	// If empty the price applies to all customers
+	CustomerID *uuid.UUID `json:"CustomerId,omitempty"`
+	// If filled the price applies to all customers in the group
+	CustomerGroupName string   `json:"CustomerGroupName,omitempty"`
+	StartDate         Date     `json:"StartDate"`
+	EndDate           Date     `json:"EndDate"`
+	Price             Decimal  `json:"Price"`
+	DiscountPct       *Decimal `json:"DiscountPct,omitempty"`
+	CurrencyCode      string   `json:"CurrencyCode,omitempty"`
+}
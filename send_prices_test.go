@@ -0,0 +1,35 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestSendPrices(t *testing.T) {
+	b := []byte(`
+		{
+			"Prices": [{
+				"ItemCode": "1234567",
+				"CustomerGroupName": "Web shop customers",
+				"StartDate": "20200101",
+				"Price": 900
+			}]
+		}
+	`)
+
+	req := client.NewSendPricesRequest()
+	err := json.Unmarshal(b, req.RequestBody())
+	if err != nil {
+		t.Error(err)
+	}
+
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ = json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
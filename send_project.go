@@ -0,0 +1,159 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewSendProjectRequest() SendProjectRequest {
+	r := SendProjectRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewSendProjectQueryParams()
+	r.pathParams = r.NewSendProjectPathParams()
+	r.requestBody = r.NewSendProjectRequestBody()
+	return r
+}
+
+type SendProjectRequest struct {
+	client      *Client
+	queryParams *SendProjectQueryParams
+	pathParams  *SendProjectPathParams
+	method      string
+	headers     http.Header
+	requestBody SendProjectRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r SendProjectRequest) Clone() SendProjectRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r SendProjectRequest) NewSendProjectQueryParams() *SendProjectQueryParams {
+	return &SendProjectQueryParams{}
+}
+
+type SendProjectQueryParams struct {
+}
+
+func (p SendProjectQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SendProjectRequest) QueryParams() *SendProjectQueryParams {
+	return r.queryParams
+}
+
+func (r SendProjectRequest) NewSendProjectPathParams() *SendProjectPathParams {
+	return &SendProjectPathParams{}
+}
+
+type SendProjectPathParams struct {
+}
+
+func (p *SendProjectPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *SendProjectRequest) PathParams() *SendProjectPathParams {
+	return r.pathParams
+}
+
+func (r *SendProjectRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SendProjectRequest) Method() string {
+	return r.method
+}
+
+func (r SendProjectRequest) NewSendProjectRequestBody() SendProjectRequestBody {
+	return SendProjectRequestBody{}
+}
+
+type SendProjectRequestBody NewProject
+
+func (r *SendProjectRequest) RequestBody() *SendProjectRequestBody {
+	return &r.requestBody
+}
+
+func (r *SendProjectRequest) SetRequestBody(body SendProjectRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SendProjectRequest) NewResponseBody() *SendProjectResponseBody {
+	return &SendProjectResponseBody{}
+}
+
+type SendProjectResponseBody struct {
+	ID uuid.UUID `json:"Id"`
+}
+
+func (r *SendProjectRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("sendproject", r.PathParams())
+}
+
+func (r *SendProjectRequest) Do(ctx context.Context, opts ...RequestOption) (SendProjectResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type NewProject struct {
+	// Required. Referenced by ProjectCode on invoice and GL rows
+	Code string `json:"Code"`
+	// Required
+	Name      string `json:"Name"`
+	StartDate Date   `json:"StartDate"`
+	EndDate   Date   `json:"EndDate"`
+}
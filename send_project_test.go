@@ -0,0 +1,32 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestSendProject(t *testing.T) {
+	b := []byte(`
+		{
+			"Code": "P001",
+			"Name": "Omniboost implementation",
+			"StartDate": "20200101"
+		}
+	`)
+
+	req := client.NewSendProjectRequest()
+	err := json.Unmarshal(b, req.RequestBody())
+	if err != nil {
+		t.Error(err)
+	}
+
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ = json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
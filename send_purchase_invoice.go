@@ -0,0 +1,224 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewSendPurchaseInvoiceRequest() SendPurchaseInvoiceRequest {
+	r := SendPurchaseInvoiceRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewSendPurchaseInvoiceQueryParams()
+	r.pathParams = r.NewSendPurchaseInvoicePathParams()
+	r.requestBody = r.NewSendPurchaseInvoiceRequestBody()
+	return r
+}
+
+type SendPurchaseInvoiceRequest struct {
+	client      *Client
+	queryParams *SendPurchaseInvoiceQueryParams
+	pathParams  *SendPurchaseInvoicePathParams
+	method      string
+	headers     http.Header
+	requestBody SendPurchaseInvoiceRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r SendPurchaseInvoiceRequest) Clone() SendPurchaseInvoiceRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r SendPurchaseInvoiceRequest) NewSendPurchaseInvoiceQueryParams() *SendPurchaseInvoiceQueryParams {
+	return &SendPurchaseInvoiceQueryParams{}
+}
+
+type SendPurchaseInvoiceQueryParams struct{}
+
+func (p SendPurchaseInvoiceQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SendPurchaseInvoiceRequest) QueryParams() *SendPurchaseInvoiceQueryParams {
+	return r.queryParams
+}
+
+func (r SendPurchaseInvoiceRequest) NewSendPurchaseInvoicePathParams() *SendPurchaseInvoicePathParams {
+	return &SendPurchaseInvoicePathParams{}
+}
+
+type SendPurchaseInvoicePathParams struct {
+}
+
+func (p *SendPurchaseInvoicePathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *SendPurchaseInvoiceRequest) PathParams() *SendPurchaseInvoicePathParams {
+	return r.pathParams
+}
+
+func (r *SendPurchaseInvoiceRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SendPurchaseInvoiceRequest) Method() string {
+	return r.method
+}
+
+func (r SendPurchaseInvoiceRequest) NewSendPurchaseInvoiceRequestBody() SendPurchaseInvoiceRequestBody {
+	return SendPurchaseInvoiceRequestBody{
+		InvoiceRow: PurchaseInvoiceRows{},
+		TaxAmount:  TaxAmounts{},
+	}
+}
+
+type SendPurchaseInvoiceRequestBody NewPurchaseInvoice
+
+func (r *SendPurchaseInvoiceRequest) RequestBody() *SendPurchaseInvoiceRequestBody {
+	return &r.requestBody
+}
+
+func (r *SendPurchaseInvoiceRequest) SetRequestBody(body SendPurchaseInvoiceRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SendPurchaseInvoiceRequest) NewResponseBody() *SendPurchaseInvoiceResponseBody {
+	return &SendPurchaseInvoiceResponseBody{}
+}
+
+type SendPurchaseInvoiceResponseBody struct {
+	VendorID  string `json:"VendorId"`
+	BillID    string `json:"BillId"`
+	BatchInfo string `json:"BatchInfo"`
+}
+
+func (r *SendPurchaseInvoiceRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("sendpurchinvoice", r.PathParams())
+}
+
+func (r *SendPurchaseInvoiceRequest) Do(ctx context.Context, opts ...RequestOption) (SendPurchaseInvoiceResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type NewPurchaseInvoice struct {
+	Vendor  NewPurchaseInvoiceVendor
+	DocDate Date
+	DueDate Date
+	// Date of the transaction in the general ledger, DocDate when empty
+	TransactionDate Date
+	// Number of the vendor's bill. Required.
+	BillNo       string
+	RefNo        string
+	BankAccount  string `json:",omitempty"`
+	CurrencyCode string
+	// Exchange rate of CurrencyCode against the company currency, taken from
+	// the currency register when empty. See GetCurrencyRatesRequest.
+	CurrencyRate   *Decimal `json:",omitempty"`
+	DepartmentCode string
+	ProjectCode    string
+	InvoiceRow     PurchaseInvoiceRows
+	TaxAmount      TaxAmounts
+	RoundingAmount Decimal
+	TotalAmount    Decimal
+	Payment        *Payment
+	Hcomment       string
+	Fcomment       string
+}
+
+type NewPurchaseInvoiceVendor struct {
+	// If filled and vendor is found in the database then following fields are
+	// not important. If not found, the vendor is added using the following
+	// fields.
+	ID *uuid.UUID `json:"Id,omitempty"`
+	// Required when vendor is added
+	Name  string `json:"Name,omitempty"`
+	RegNo string `json:"RegNo,omitempty"`
+	// Required when vendor is added
+	VatAccountable bool   `json:"VatAccountable,omitempty"`
+	VatRegNo       string `json:"VatRegNo,omitempty"`
+	CurrencyCode   string `json:"CurrencyCode,omitempty"`
+	// If missing then taken from default settings.
+	PaymentDeadLine int `json:"PaymentDeadLine,omitempty"`
+	// If missing then taken from default settings.
+	OverDueCharge *Decimal `json:"OverDueCharge,omitempty"`
+	Address       string   `json:"Address,omitempty"`
+	City          string   `json:"City,omitempty"`
+	County        string   `json:"County,omitempty"`
+	PostalCode    string   `json:"PostalCode,omitempty"`
+	// Required when adding
+	CountryCode string
+	PhoneNo     string `json:"PhoneNo,omitempty"`
+	PhoneNo2    string `json:"PhoneNo2,omitempty"`
+	HomePage    string `json:"HomePage,omitempty"`
+	Email       string `json:"Email,omitempty"`
+}
+
+type PurchaseInvoiceRows []PurchaseInvoiceRow
+
+type PurchaseInvoiceRow struct {
+	Item           Article
+	Quantity       Decimal
+	Price          Decimal
+	DiscountPct    Decimal
+	DiscountAmount Decimal
+	TaxID          uuid.UUID `json:"TaxId"`
+	LocationCode   string
+	DepartmentCode string
+	// Overrides the purchase account of the item for this row
+	GLAccountCode  string `json:"GLAccountCode,omitempty"`
+	ProjectCode    string
+	CostCenterCode string
+}
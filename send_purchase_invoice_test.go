@@ -0,0 +1,61 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestSendPurchaseInvoice(t *testing.T) {
+	b := []byte(`
+		{
+			"Vendor": {
+				"Name": "Omniboost B.V.",
+				"RegNo": "1122334455",
+				"VatAccountable": true,
+				"VatRegNo": "NL11223344",
+				"CurrencyCode": "EUR",
+				"Address": "Stadhuisplein 3",
+				"City": "Terneuzen",
+				"CountryCode": "NL",
+				"Email": "leon@omniboost.io"
+			},
+			"DocDate": "20200101",
+			"DueDate": "20200115",
+			"BillNo": "B-123",
+			"RefNo": "",
+			"InvoiceRow": [{
+				"Item": {
+					"Code": "1234567",
+					"Description": "Bag of goldflakes",
+					"Type": 3,
+					"UOMName": "kg"
+				},
+				"Quantity": 2,
+				"Price": 1000,
+				"TaxId": "973a4395-665f-47a6-a5b6-5384dd24f8d0",
+				"LocationCode": "1"
+			}],
+			"TotalAmount": 2000,
+			"RoundingAmount": 0,
+			"TaxAmount": [],
+			"HComment": "Header",
+			"FComment": "Footer"
+		}
+	`)
+
+	req := client.NewSendPurchaseInvoiceRequest()
+	err := json.Unmarshal(b, req.RequestBody())
+	if err != nil {
+		t.Error(err)
+	}
+
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ = json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,162 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewSendUnitsRequest() SendUnitsRequest {
+	r := SendUnitsRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewSendUnitsQueryParams()
+	r.pathParams = r.NewSendUnitsPathParams()
+	r.requestBody = r.NewSendUnitsRequestBody()
+	return r
+}
+
+type SendUnitsRequest struct {
+	client      *Client
+	queryParams *SendUnitsQueryParams
+	pathParams  *SendUnitsPathParams
+	method      string
+	headers     http.Header
+	requestBody SendUnitsRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r SendUnitsRequest) Clone() SendUnitsRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r SendUnitsRequest) NewSendUnitsQueryParams() *SendUnitsQueryParams {
+	return &SendUnitsQueryParams{}
+}
+
+type SendUnitsQueryParams struct {
+}
+
+func (p SendUnitsQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SendUnitsRequest) QueryParams() *SendUnitsQueryParams {
+	return r.queryParams
+}
+
+func (r SendUnitsRequest) NewSendUnitsPathParams() *SendUnitsPathParams {
+	return &SendUnitsPathParams{}
+}
+
+type SendUnitsPathParams struct {
+}
+
+func (p *SendUnitsPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *SendUnitsRequest) PathParams() *SendUnitsPathParams {
+	return r.pathParams
+}
+
+func (r *SendUnitsRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SendUnitsRequest) Method() string {
+	return r.method
+}
+
+func (r SendUnitsRequest) NewSendUnitsRequestBody() SendUnitsRequestBody {
+	return SendUnitsRequestBody{}
+}
+
+type SendUnitsRequestBody struct {
+	Units NewUnits `json:"Units"`
+}
+
+func (r *SendUnitsRequest) RequestBody() *SendUnitsRequestBody {
+	return &r.requestBody
+}
+
+func (r *SendUnitsRequest) SetRequestBody(body SendUnitsRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SendUnitsRequest) NewResponseBody() *SendUnitsResponseBody {
+	return &SendUnitsResponseBody{}
+}
+
+type SendUnitsResponseBody []struct {
+	ID   uuid.UUID `json:"Id"`
+	Code string    `json:"Code"`
+}
+
+func (r *SendUnitsRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("sendunits", r.PathParams())
+}
+
+func (r *SendUnitsRequest) Do(ctx context.Context, opts ...RequestOption) (SendUnitsResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type NewUnits []NewUnit
+
+type NewUnit struct {
+	// Required
+	Code string `json:"Code"`
+	// Required
+	Name string `json:"Name"`
+}
@@ -0,0 +1,182 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewSendVendorRequest() SendVendorRequest {
+	r := SendVendorRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewSendVendorQueryParams()
+	r.pathParams = r.NewSendVendorPathParams()
+	r.requestBody = r.NewSendVendorRequestBody()
+	return r
+}
+
+type SendVendorRequest struct {
+	client      *Client
+	queryParams *SendVendorQueryParams
+	pathParams  *SendVendorPathParams
+	method      string
+	headers     http.Header
+	requestBody SendVendorRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r SendVendorRequest) Clone() SendVendorRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r SendVendorRequest) NewSendVendorQueryParams() *SendVendorQueryParams {
+	return &SendVendorQueryParams{}
+}
+
+type SendVendorQueryParams struct {
+}
+
+func (p SendVendorQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SendVendorRequest) QueryParams() *SendVendorQueryParams {
+	return r.queryParams
+}
+
+func (r SendVendorRequest) NewSendVendorPathParams() *SendVendorPathParams {
+	return &SendVendorPathParams{}
+}
+
+type SendVendorPathParams struct {
+}
+
+func (p *SendVendorPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *SendVendorRequest) PathParams() *SendVendorPathParams {
+	return r.pathParams
+}
+
+func (r *SendVendorRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SendVendorRequest) Method() string {
+	return r.method
+}
+
+func (r SendVendorRequest) NewSendVendorRequestBody() SendVendorRequestBody {
+	return SendVendorRequestBody{}
+}
+
+type SendVendorRequestBody NewVendor
+
+func (r *SendVendorRequest) RequestBody() *SendVendorRequestBody {
+	return &r.requestBody
+}
+
+func (r *SendVendorRequest) SetRequestBody(body SendVendorRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SendVendorRequest) NewResponseBody() *SendVendorResponseBody {
+	return &SendVendorResponseBody{}
+}
+
+type SendVendorResponseBody struct {
+	VendorID uuid.UUID `json:"VendorId"`
+	Name     string    `json:"Name"`
+}
+
+func (r *SendVendorRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("sendvendor", r.PathParams())
+}
+
+func (r *SendVendorRequest) Do(ctx context.Context, opts ...RequestOption) (SendVendorResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type NewVendor struct {
+	// Required
+	Name string `json:"Name"`
+	// Required
+	RegNo string `json:"RegNo,omitempty"`
+	// Required. True for vendors that are VAT registered. Allowed “true” or
+	// “false” (lowercase).
+	VatAccountable bool   `json:"VatAccountable"`
+	VatRegNo       string `json:"VatRegNo,omitempty"`
+	CurrencyCode   string `json:"CurrencyCode,omitempty"`
+	// If missing then taken from default settings.
+	PaymentDeadLine int `json:"PaymentDeadLine,omitempty"`
+	// If missing then taken from default settings.
+	OverdueCharge *Decimal `json:"OverdueCharge,omitempty"`
+	Address       string   `json:"Address,omitempty"`
+	City          string   `json:"City,omitempty"`
+	County        string   `json:"County,omitempty"`
+	PostalCode    string   `json:"PostalCode,omitempty"`
+	// Required
+	CountryCode     string `json:"CountryCode"`
+	PhoneNo         string `json:"PhoneNo,omitempty"`
+	PhoneNo2        string `json:"PhoneNo2,omitempty"`
+	HomePage        string `json:"HomePage,omitempty"`
+	Email           string `json:"Email,omitempty"`
+	BankAccount     string `json:"BankAccount,omitempty"`
+	ReceiverName    string `json:"ReceiverName,omitempty"`
+	VendorGroupName string `json:"VendorGroupName,omitempty"`
+	// Default expense account used when posting purchase invoices
+	ExpenseAccountCode string `json:"ExpenseAccountCode,omitempty"`
+}
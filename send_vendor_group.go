@@ -0,0 +1,157 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewSendVendorGroupRequest() SendVendorGroupRequest {
+	r := SendVendorGroupRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewSendVendorGroupQueryParams()
+	r.pathParams = r.NewSendVendorGroupPathParams()
+	r.requestBody = r.NewSendVendorGroupRequestBody()
+	return r
+}
+
+type SendVendorGroupRequest struct {
+	client      *Client
+	queryParams *SendVendorGroupQueryParams
+	pathParams  *SendVendorGroupPathParams
+	method      string
+	headers     http.Header
+	requestBody SendVendorGroupRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r SendVendorGroupRequest) Clone() SendVendorGroupRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r SendVendorGroupRequest) NewSendVendorGroupQueryParams() *SendVendorGroupQueryParams {
+	return &SendVendorGroupQueryParams{}
+}
+
+type SendVendorGroupQueryParams struct {
+}
+
+func (p SendVendorGroupQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SendVendorGroupRequest) QueryParams() *SendVendorGroupQueryParams {
+	return r.queryParams
+}
+
+func (r SendVendorGroupRequest) NewSendVendorGroupPathParams() *SendVendorGroupPathParams {
+	return &SendVendorGroupPathParams{}
+}
+
+type SendVendorGroupPathParams struct {
+}
+
+func (p *SendVendorGroupPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *SendVendorGroupRequest) PathParams() *SendVendorGroupPathParams {
+	return r.pathParams
+}
+
+func (r *SendVendorGroupRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SendVendorGroupRequest) Method() string {
+	return r.method
+}
+
+func (r SendVendorGroupRequest) NewSendVendorGroupRequestBody() SendVendorGroupRequestBody {
+	return SendVendorGroupRequestBody{}
+}
+
+type SendVendorGroupRequestBody NewVendorGroup
+
+func (r *SendVendorGroupRequest) RequestBody() *SendVendorGroupRequestBody {
+	return &r.requestBody
+}
+
+func (r *SendVendorGroupRequest) SetRequestBody(body SendVendorGroupRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SendVendorGroupRequest) NewResponseBody() *SendVendorGroupResponseBody {
+	return &SendVendorGroupResponseBody{}
+}
+
+type SendVendorGroupResponseBody struct {
+	ID uuid.UUID `json:"Id"`
+}
+
+func (r *SendVendorGroupRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("sendvendorgroup", r.PathParams())
+}
+
+func (r *SendVendorGroupRequest) Do(ctx context.Context, opts ...RequestOption) (SendVendorGroupResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type NewVendorGroup struct {
+	// Required
+	Code string `json:"Code"`
+	// Required
+	Name string `json:"Name"`
+}
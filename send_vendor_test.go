@@ -0,0 +1,40 @@
+package aktiva_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestSendVendor(t *testing.T) {
+	b := []byte(`
+		{
+			"Name": "Omniboost B.V.",
+			"RegNo": "1122334455",
+			"VatAccountable": true,
+			"VatRegNo": "NL11223344B01",
+			"CurrencyCode": "EUR",
+			"PaymentDeadLine": 14,
+			"Address": "Stadhuisplein 3",
+			"CountryCode": "NL",
+			"City": "Terneuzen",
+			"Email": "leon@omniboost.io",
+			"ExpenseAccountCode": "4000"
+		}
+	`)
+
+	req := client.NewSendVendorRequest()
+	err := json.Unmarshal(b, req.RequestBody())
+	if err != nil {
+		t.Error(err)
+	}
+
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ = json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -30,6 +30,15 @@ func TestMain(m *testing.M) {
 		}
 		client.SetBaseURL(*baseURL)
 	}
+
+	// prefer the demo company so the tests don't touch a live book
+	sandbox, err := aktiva.NewSandboxClient(nil)
+	if err == nil {
+		client = sandbox
+	} else if err != aktiva.ErrNoSandbox {
+		log.Fatal(err)
+	}
+
 	client.SetDisallowUnknownFields(true)
 	m.Run()
 }
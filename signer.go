@@ -0,0 +1,79 @@
+package aktiva
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+// Signer authenticates a request. NewRequest calls it after the body is
+// encoded, and it's called again when a request is retried.
+type Signer interface {
+	Sign(req *http.Request, body *bytes.Buffer) error
+}
+
+// SignerFunc is an adapter to use an ordinary function as a Signer
+type SignerFunc func(req *http.Request, body *bytes.Buffer) error
+
+func (f SignerFunc) Sign(req *http.Request, body *bytes.Buffer) error {
+	return f(req, body)
+}
+
+// querySigner adds the ApiId, timestamp and signature query parameters with
+// the credentials of the client
+type querySigner struct {
+	client *Client
+}
+
+func (s querySigner) Sign(req *http.Request, body *bytes.Buffer) error {
+	c := s.client
+
+	query := req.URL.Query()
+	query.Del("ApiId")
+	query.Del("timestamp")
+	query.Del("signature")
+	req.URL.RawQuery = query.Encode()
+
+	apiID, apiKey := c.credentials()
+	values := url.Values{}
+	values.Add("ApiId", apiID)
+	timestamp := c.GenerateTimestamp()
+	values.Add("timestamp", timestamp.String())
+	values.Add("signature", generateSignature(apiID, apiKey, timestamp, body))
+
+	return utils.AddURLValuesToRequest(values, req, true)
+}
+
+// VerifySignature reports whether signature is the signature Merit expects
+// for the ApiId and timestamp query parameters and the raw request body, so a
+// fake Merit server can check the requests of the client
+func VerifySignature(apiID, apiKey, timestamp string, body []byte, signature string) bool {
+	mac, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(mac, signatureMAC(apiID, apiKey, timestamp, body))
+}
+
+// Signer returns the signer of the requests, by default the query signature
+// of the Merit API
+func (c *Client) Signer() Signer {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.signer == nil {
+		return querySigner{client: c}
+	}
+	return c.signer
+}
+
+// SetSigner replaces the default query signature, nil restores it
+func (c *Client) SetSigner(signer Signer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.signer = signer
+}
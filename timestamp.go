@@ -0,0 +1,29 @@
+package aktiva
+
+import "time"
+
+const (
+	// DateLayout is the layout of dates in request bodies: yyyyMMdd
+	DateLayout = "20060102"
+	// DateTimeLayout is the layout of date times in request bodies:
+	// yyyyMMddHHmmss
+	DateTimeLayout = "20060102150405"
+	// TimestampLayout is the layout of the timestamp query parameter, which is
+	// also part of the signed data: yyyyMMddHHmmss
+	TimestampLayout = "20060102150405"
+)
+
+// Timestamp is the value of the timestamp query parameter. The exact same
+// string is used when calculating the signature, so both must be generated
+// from one Timestamp.
+type Timestamp struct {
+	time.Time
+}
+
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{t}
+}
+
+func (t Timestamp) String() string {
+	return t.Time.Format(TimestampLayout)
+}
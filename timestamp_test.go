@@ -0,0 +1,66 @@
+package aktiva_test
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	aktiva "github.com/omniboost/go-merit-aktiva"
+)
+
+func TestTimestampFormat(t *testing.T) {
+	ts := aktiva.NewTimestamp(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+	if ts.String() != "20200102030405" {
+		t.Errorf("expected yyyyMMddHHmmss timestamp, got %s", ts)
+	}
+}
+
+func TestGenerateSignature(t *testing.T) {
+	c := aktiva.NewClient(nil, "c9859e50-d725-44bb-a1b5-8e411aad4674", "secret")
+	ts := aktiva.NewTimestamp(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+	body := `{"PeriodStart":"20200101"}`
+
+	h := hmac.New(sha256.New, []byte("secret"))
+	h.Write([]byte("c9859e50-d725-44bb-a1b5-8e411aad4674" + "20200102030405" + body))
+	expected := base64.StdEncoding.EncodeToString(h.Sum(nil))
+
+	signature := c.GenerateSignature(ts, bytes.NewBufferString(body))
+	if signature != expected {
+		t.Errorf("expected signature %s, got %s", expected, signature)
+	}
+}
+
+func TestClientLocation(t *testing.T) {
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetLocation(time.FixedZone("EET", 2*60*60))
+
+	// 23:30 UTC is already the next day in Estonia
+	utc := time.Date(2020, 1, 31, 23, 30, 0, 0, time.UTC)
+
+	if d := c.Date(utc).String(); d != "20200201" {
+		t.Errorf("expected 20200201, got %s", d)
+	}
+
+	if d := c.DateTime(utc).String(); d != "20200201013000" {
+		t.Errorf("expected 20200201013000, got %s", d)
+	}
+
+	if c.GenerateTimestamp().Location() != c.Location() {
+		t.Error("expected the timestamp in the client location")
+	}
+}
+
+func TestSetClock(t *testing.T) {
+	c := aktiva.NewClient(nil, "id", "key")
+	c.SetLocation(time.UTC)
+	c.SetClock(func() time.Time {
+		return time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	})
+
+	if ts := c.GenerateTimestamp().String(); ts != "20200102030405" {
+		t.Errorf("expected the clock's time, got %s", ts)
+	}
+}
@@ -0,0 +1,162 @@
+package aktiva
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// TransportOptions tunes the transport owned by the client. Zero values keep
+// the defaults of http.DefaultTransport.
+type TransportOptions struct {
+	// Maximum time to establish a TCP connection
+	DialTimeout time.Duration
+	// Interval of TCP keep-alive probes, negative disables them
+	KeepAlive time.Duration
+	// Maximum time for the TLS handshake
+	TLSHandshakeTimeout time.Duration
+	// Maximum time to wait for the response headers after sending the request
+	ResponseHeaderTimeout time.Duration
+	// How long idle connections are kept open
+	IdleConnTimeout time.Duration
+	// Maximum number of idle connections, over all hosts
+	MaxIdleConns int
+	// Maximum number of idle connections to the Merit host
+	MaxIdleConnsPerHost int
+}
+
+// SetTransportOptions replaces the transport with a new one configured with
+// options. Use it instead of SetHTTPClient when only the connection settings
+// need to change.
+func (c *Client) SetTransportOptions(options TransportOptions) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.transport = newTransport(options)
+	c.applyTransport()
+}
+
+// newTransport returns a copy of http.DefaultTransport with options applied
+func newTransport(options TransportOptions) *http.Transport {
+	transport, ok := http.DefaultTransport.(*http.Transport)
+	if ok {
+		transport = transport.Clone()
+	} else {
+		// replaced by the application, start from the standard settings
+		transport = &http.Transport{
+			Proxy:                 http.ProxyFromEnvironment,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: time.Second,
+		}
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	if options.DialTimeout != 0 {
+		dialer.Timeout = options.DialTimeout
+	}
+	if options.KeepAlive != 0 {
+		dialer.KeepAlive = options.KeepAlive
+	}
+	transport.DialContext = dialer.DialContext
+
+	if options.TLSHandshakeTimeout != 0 {
+		transport.TLSHandshakeTimeout = options.TLSHandshakeTimeout
+	}
+	if options.ResponseHeaderTimeout != 0 {
+		transport.ResponseHeaderTimeout = options.ResponseHeaderTimeout
+	}
+	if options.IdleConnTimeout != 0 {
+		transport.IdleConnTimeout = options.IdleConnTimeout
+	}
+	if options.MaxIdleConns != 0 {
+		transport.MaxIdleConns = options.MaxIdleConns
+	}
+	if options.MaxIdleConnsPerHost != 0 {
+		transport.MaxIdleConnsPerHost = options.MaxIdleConnsPerHost
+	}
+
+	return transport
+}
+
+// SetTimeout limits the duration of every request attempt, including reading
+// the response body. Zero means no limit; see WithTimeout for a limit per call
+// including retries.
+func (c *Client) SetTimeout(timeout time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	httpClient := *c.http
+	httpClient.Timeout = timeout
+	c.http = &httpClient
+}
+
+func (c *Client) Timeout() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.http.Timeout
+}
+
+// SetProxy sends all requests through the proxy at proxyURL, nil restores the
+// proxy of the transport (by default from the HTTP_PROXY and HTTPS_PROXY
+// environment variables). It only applies to *http.Transport transports.
+func (c *Client) SetProxy(proxyURL *url.URL) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.proxy = proxyURL
+	c.applyTransport()
+}
+
+func (c *Client) Proxy() *url.URL {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.proxy
+}
+
+// SetTLSConfig sets the TLS configuration of the connections, e.g. with the
+// root CAs of a corporate proxy. nil restores the configuration of the
+// transport. It only applies to *http.Transport transports.
+func (c *Client) SetTLSConfig(config *tls.Config) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tlsConfig = config
+	c.applyTransport()
+}
+
+func (c *Client) TLSConfig() *tls.Config {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.tlsConfig
+}
+
+// networkTransport returns the transport with the proxy and TLS
+// configuration applied. The transport is copied so one set with
+// SetHTTPClient or SetTransport isn't changed. The caller holds the lock.
+func (c *Client) networkTransport() http.RoundTripper {
+	if c.proxy == nil && c.tlsConfig == nil {
+		return c.transport
+	}
+
+	transport, ok := c.transport.(*http.Transport)
+	if !ok {
+		return c.transport
+	}
+
+	transport = transport.Clone()
+	if c.proxy != nil {
+		transport.Proxy = http.ProxyURL(c.proxy)
+	}
+	if c.tlsConfig != nil {
+		transport.TLSClientConfig = c.tlsConfig.Clone()
+	}
+	return transport
+}
@@ -0,0 +1,47 @@
+package aktiva
+
+import "strconv"
+
+// ItemType is the type of an item (article) as used in the Type field of
+// items and invoice rows
+type ItemType int
+
+const (
+	ItemTypeStock   ItemType = 1
+	ItemTypeService ItemType = 2
+	ItemTypeItem    ItemType = 3
+)
+
+func (t ItemType) String() string {
+	switch t {
+	case ItemTypeStock:
+		return "stock item"
+	case ItemTypeService:
+		return "service"
+	case ItemTypeItem:
+		return "item"
+	}
+	return "ItemType(" + strconv.Itoa(int(t)) + ")"
+}
+
+// SalesReportGrouping determines what the rows of the sales report are
+// totalled by
+type SalesReportGrouping int
+
+const (
+	SalesReportByItem     SalesReportGrouping = 1
+	SalesReportByCustomer SalesReportGrouping = 2
+	SalesReportByPeriod   SalesReportGrouping = 3
+)
+
+func (g SalesReportGrouping) String() string {
+	switch g {
+	case SalesReportByItem:
+		return "item"
+	case SalesReportByCustomer:
+		return "customer"
+	case SalesReportByPeriod:
+		return "period"
+	}
+	return "SalesReportGrouping(" + strconv.Itoa(int(g)) + ")"
+}
@@ -0,0 +1,166 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewUpdateItemRequest() UpdateItemRequest {
+	r := UpdateItemRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewUpdateItemQueryParams()
+	r.pathParams = r.NewUpdateItemPathParams()
+	r.requestBody = r.NewUpdateItemRequestBody()
+	return r
+}
+
+type UpdateItemRequest struct {
+	client      *Client
+	queryParams *UpdateItemQueryParams
+	pathParams  *UpdateItemPathParams
+	method      string
+	headers     http.Header
+	requestBody UpdateItemRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r UpdateItemRequest) Clone() UpdateItemRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r UpdateItemRequest) NewUpdateItemQueryParams() *UpdateItemQueryParams {
+	return &UpdateItemQueryParams{}
+}
+
+type UpdateItemQueryParams struct {
+}
+
+func (p UpdateItemQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *UpdateItemRequest) QueryParams() *UpdateItemQueryParams {
+	return r.queryParams
+}
+
+func (r UpdateItemRequest) NewUpdateItemPathParams() *UpdateItemPathParams {
+	return &UpdateItemPathParams{}
+}
+
+type UpdateItemPathParams struct {
+}
+
+func (p *UpdateItemPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *UpdateItemRequest) PathParams() *UpdateItemPathParams {
+	return r.pathParams
+}
+
+func (r *UpdateItemRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *UpdateItemRequest) Method() string {
+	return r.method
+}
+
+func (r UpdateItemRequest) NewUpdateItemRequestBody() UpdateItemRequestBody {
+	return UpdateItemRequestBody{}
+}
+
+type UpdateItemRequestBody UpdatedItem
+
+func (r *UpdateItemRequest) RequestBody() *UpdateItemRequestBody {
+	return &r.requestBody
+}
+
+func (r *UpdateItemRequest) SetRequestBody(body UpdateItemRequestBody) {
+	r.requestBody = body
+}
+
+func (r *UpdateItemRequest) NewResponseBody() *UpdateItemResponseBody {
+	return &UpdateItemResponseBody{}
+}
+
+type UpdateItemResponseBody struct{}
+
+func (r *UpdateItemRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("updateitem", r.PathParams())
+}
+
+func (r *UpdateItemRequest) Do(ctx context.Context, opts ...RequestOption) (UpdateItemResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type UpdatedItem struct {
+	// Required. Use getitems endpoint to detect the guid needed
+	ID uuid.UUID `json:"Id"`
+	// Only filled fields are updated
+	Code                 string           `json:"Code,omitempty"`
+	Description          string           `json:"Description,omitempty"`
+	Type                 ItemType         `json:"Type,omitempty"`
+	UOMName              string           `json:"UOMName,omitempty"`
+	SalesPrice           *Decimal         `json:"SalesPrice,omitempty"`
+	TaxID                *uuid.UUID       `json:"TaxId,omitempty"`
+	ItemGroupName        string           `json:"ItemGroupName,omitempty"`
+	SalesAccountCode     string           `json:"SalesAccountCode,omitempty"`
+	PurchaseAccountCode  string           `json:"PurchaseAccountCode,omitempty"`
+	InventoryAccountCode string           `json:"InventoryAccountCode,omitempty"`
+	CostAccountCode      string           `json:"CostAccountCode,omitempty"`
+	Descriptions         ItemDescriptions `json:"Descriptions,omitempty"`
+}
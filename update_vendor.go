@@ -0,0 +1,174 @@
+package aktiva
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/omniboost/go-merit-aktiva/utils"
+)
+
+func (c *Client) NewUpdateVendorRequest() UpdateVendorRequest {
+	r := UpdateVendorRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewUpdateVendorQueryParams()
+	r.pathParams = r.NewUpdateVendorPathParams()
+	r.requestBody = r.NewUpdateVendorRequestBody()
+	return r
+}
+
+type UpdateVendorRequest struct {
+	client      *Client
+	queryParams *UpdateVendorQueryParams
+	pathParams  *UpdateVendorPathParams
+	method      string
+	headers     http.Header
+	requestBody UpdateVendorRequestBody
+}
+
+// Clone returns a copy of the request with its own query parameters, path
+// parameters and headers. Slices in the request body are shared. A request
+// must not be changed while its Do runs, give each goroutine its own clone.
+func (r UpdateVendorRequest) Clone() UpdateVendorRequest {
+	clone := r
+
+	queryParams := *r.queryParams
+	clone.queryParams = &queryParams
+
+	pathParams := *r.pathParams
+	clone.pathParams = &pathParams
+
+	clone.headers = r.headers.Clone()
+	return clone
+}
+
+func (r UpdateVendorRequest) NewUpdateVendorQueryParams() *UpdateVendorQueryParams {
+	return &UpdateVendorQueryParams{}
+}
+
+type UpdateVendorQueryParams struct {
+}
+
+func (p UpdateVendorQueryParams) ToURLValues() (url.Values, error) {
+	encoder := NewSchemaEncoder()
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *UpdateVendorRequest) QueryParams() *UpdateVendorQueryParams {
+	return r.queryParams
+}
+
+func (r UpdateVendorRequest) NewUpdateVendorPathParams() *UpdateVendorPathParams {
+	return &UpdateVendorPathParams{}
+}
+
+type UpdateVendorPathParams struct {
+}
+
+func (p *UpdateVendorPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *UpdateVendorRequest) PathParams() *UpdateVendorPathParams {
+	return r.pathParams
+}
+
+func (r *UpdateVendorRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *UpdateVendorRequest) Method() string {
+	return r.method
+}
+
+func (r UpdateVendorRequest) NewUpdateVendorRequestBody() UpdateVendorRequestBody {
+	return UpdateVendorRequestBody{}
+}
+
+type UpdateVendorRequestBody UpdatedVendor
+
+func (r *UpdateVendorRequest) RequestBody() *UpdateVendorRequestBody {
+	return &r.requestBody
+}
+
+func (r *UpdateVendorRequest) SetRequestBody(body UpdateVendorRequestBody) {
+	r.requestBody = body
+}
+
+func (r *UpdateVendorRequest) NewResponseBody() *UpdateVendorResponseBody {
+	return &UpdateVendorResponseBody{}
+}
+
+type UpdateVendorResponseBody struct{}
+
+func (r *UpdateVendorRequest) URL() (url.URL, error) {
+	return r.client.GetEndpointURL("updatevendor", r.PathParams())
+}
+
+func (r *UpdateVendorRequest) Do(ctx context.Context, opts ...RequestOption) (UpdateVendorResponseBody, error) {
+	ctx, cancel := ApplyRequestOptions(ctx, opts)
+	defer cancel()
+
+	// work on a snapshot, r must not be changed until Do returns: clone
+	// it to send variations of it concurrently
+	snapshot := r.Clone()
+
+	u, err := snapshot.URL()
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Create http request
+	req, err := r.client.NewRequest(ctx, snapshot.Method(), u, snapshot.RequestBody())
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(snapshot.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+
+type UpdatedVendor struct {
+	// Required. Use getvendors endpoint to detect the guid needed
+	ID uuid.UUID `json:"Id"`
+	// Only filled fields are updated
+	Name               string   `json:"Name,omitempty"`
+	RegNo              string   `json:"RegNo,omitempty"`
+	VatAccountable     *bool    `json:"VatAccountable,omitempty"`
+	VatRegNo           string   `json:"VatRegNo,omitempty"`
+	CurrencyCode       string   `json:"CurrencyCode,omitempty"`
+	PaymentDeadLine    int      `json:"PaymentDeadLine,omitempty"`
+	OverdueCharge      *Decimal `json:"OverdueCharge,omitempty"`
+	Address            string   `json:"Address,omitempty"`
+	City               string   `json:"City,omitempty"`
+	County             string   `json:"County,omitempty"`
+	PostalCode         string   `json:"PostalCode,omitempty"`
+	CountryCode        string   `json:"CountryCode,omitempty"`
+	PhoneNo            string   `json:"PhoneNo,omitempty"`
+	PhoneNo2           string   `json:"PhoneNo2,omitempty"`
+	HomePage           string   `json:"HomePage,omitempty"`
+	Email              string   `json:"Email,omitempty"`
+	BankAccount        string   `json:"BankAccount,omitempty"`
+	ReceiverName       string   `json:"ReceiverName,omitempty"`
+	VendorGroupName    string   `json:"VendorGroupName,omitempty"`
+	ExpenseAccountCode string   `json:"ExpenseAccountCode,omitempty"`
+}
@@ -11,7 +11,6 @@ import (
 	null "gopkg.in/guregu/null.v3"
 
 	"github.com/gorilla/schema"
-	"github.com/omniboost/go-exactglobe-webservices/odata"
 )
 
 type SchemaMarshaler interface {
@@ -66,31 +65,26 @@ func AddURLValuesToRequest(params url.Values, req *http.Request, skipEmpty bool)
 	return nil
 }
 
-func NewSchemaEncoder() *schema.Encoder {
-	encoder := schema.NewEncoder()
-
-	// // register custom encoders
-	encodeSchemaMarshaler := func(v reflect.Value) string {
-		marshaler, ok := v.Interface().(SchemaMarshaler)
-		if ok == true {
-			return marshaler.MarshalSchema()
-		}
-
-		stringer, ok := v.Interface().(fmt.Stringer)
-		if ok == true {
-			return stringer.String()
-		}
+// EncodeSchemaMarshaler encodes SchemaMarshaler and fmt.Stringer values, it
+// can be registered on a schema encoder for custom types
+func EncodeSchemaMarshaler(v reflect.Value) string {
+	marshaler, ok := v.Interface().(SchemaMarshaler)
+	if ok == true {
+		return marshaler.MarshalSchema()
+	}
 
-		return ""
+	stringer, ok := v.Interface().(fmt.Stringer)
+	if ok == true {
+		return stringer.String()
 	}
 
-	encoder.RegisterEncoder(&odata.Expand{}, encodeSchemaMarshaler)
-	encoder.RegisterEncoder(&odata.Filter{}, encodeSchemaMarshaler)
-	encoder.RegisterEncoder(&odata.Select{}, encodeSchemaMarshaler)
-	encoder.RegisterEncoder(&odata.Top{}, encodeSchemaMarshaler)
-	encoder.RegisterEncoder(&odata.OrderBy{}, encodeSchemaMarshaler)
-	encoder.RegisterEncoder(&odata.Skip{}, encodeSchemaMarshaler)
+	return ""
+}
+
+func NewSchemaEncoder() *schema.Encoder {
+	encoder := schema.NewEncoder()
 
+	// register custom encoders
 	encodeNullFloat := func(v reflect.Value) string {
 		nullFloat, _ := v.Interface().(null.Float)
 		if nullFloat.IsZero() {